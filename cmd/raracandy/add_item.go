@@ -3,7 +3,7 @@ package main
 import (
 	"fmt"
 
-	"github.com/abravonunez/raracandy/internal/backup"
+	"github.com/abravonunez/raracandy/internal/edit"
 	"github.com/abravonunez/raracandy/internal/gen1/items"
 	"github.com/abravonunez/raracandy/internal/gen1/save"
 	"github.com/spf13/cobra"
@@ -56,104 +56,44 @@ func runAddItem(cmd *cobra.Command, args []string) error {
 	if err != nil {
 		return fmt.Errorf("invalid item: %w", err)
 	}
-
-	// Load save file
-	fmt.Println("⚙️  Loading save...")
-	s, err := save.Load(savePath)
-	if err != nil {
-		return fmt.Errorf("failed to load save: %w", err)
-	}
-
-	// Perform integrity check
-	fmt.Println("🔍 Running integrity check...")
-	report := s.CheckIntegrity()
-
-	if !report.IsValid {
-		fmt.Println("\n❌ Save file integrity check failed:")
-		for _, err := range report.Errors {
-			fmt.Printf("  • %s\n", err)
-		}
-		return fmt.Errorf("cannot modify corrupted save file")
-	}
-
-	fmt.Printf("✓ Integrity check passed\n")
-	fmt.Printf("✓ Detected: %s\n", report.GameVersion)
-	fmt.Println()
-
-	// Find current state
-	currentIdx := items.FindItemIndex(s, itemID)
-	var currentQty byte = 0
-	if currentIdx >= 0 {
-		bagItems := items.GetBagItems(s)
-		currentQty = bagItems[currentIdx].Quantity
+	if err := checkItemQuantity(itemID, addItemQty); err != nil {
+		return err
 	}
 
-	// Preview changes
 	itemName := items.GetItemName(itemID)
-	fmt.Println("Changes to be applied:")
-	fmt.Println("  Bag items:")
-	if currentIdx >= 0 {
-		fmt.Printf("    - %s: %d → %d (%+d)\n", itemName, currentQty, addItemQty, addItemQty-int(currentQty))
-	} else {
-		fmt.Printf("    - %s: (new) → %d\n", itemName, addItemQty)
-	}
-
-	oldChecksum := s.GetChecksum()
-	fmt.Printf("  Checksum: 0x%02X → (will recalculate)\n", oldChecksum)
-
-	if addItemDryRun {
-		fmt.Println("\n[DRY RUN] No changes written")
-		return nil
-	}
-
-	// Ask for confirmation if not in force mode
-	if !addItemForce {
-		changes := []string{
-			fmt.Sprintf("Add/modify %s to quantity %d", itemName, addItemQty),
-			"Recalculate checksum",
-		}
-		if !save.ConfirmWithDetails(changes) {
-			fmt.Println("\n❌ Operation cancelled by user")
+	return runSaveEdit(saveEdit{
+		savePath: savePath,
+		output:   addItemOutput,
+		dryRun:   addItemDryRun,
+		force:    addItemForce,
+		plan: func(tx *edit.Transaction) error {
+			fmt.Println("  Bag items:")
+			if currentIdx := items.FindItemIndex(tx.Save, itemID); currentIdx >= 0 {
+				currentQty := items.GetBagItems(tx.Save)[currentIdx].Quantity
+				fmt.Printf("    - %s: %d → %d (%+d)\n", itemName, currentQty, addItemQty, addItemQty-int(currentQty))
+			} else {
+				fmt.Printf("    - %s: (new) → %d\n", itemName, addItemQty)
+			}
+			tx.Add(fmt.Sprintf("Add/modify %s to quantity %d", itemName, addItemQty), func(s *save.Save) error {
+				if err := items.SetItemQuantity(s, itemID, byte(addItemQty)); err != nil {
+					return fmt.Errorf("failed to set item: %w", err)
+				}
+				return nil
+			})
 			return nil
-		}
-	}
-
-	// Apply changes
-	fmt.Println("\n✍️  Applying changes...")
-	if err := items.SetItemQuantity(s, itemID, byte(addItemQty)); err != nil {
-		return fmt.Errorf("failed to set item: %w", err)
-	}
-
-	// Get original hash before backup
-	originalHash := s.GetSHA256()
-
-	// Create backup with hash
-	fmt.Println("💾 Creating backup...")
-	if err := backup.CreateBackupWithHash(savePath, originalHash); err != nil {
-		return fmt.Errorf("failed to create backup: %w", err)
-	}
-	fmt.Printf("✓ Backup created: %s\n", backup.GetBackupPath(savePath))
-	fmt.Printf("✓ Backup hash saved: %s.bak.sha256\n", savePath)
-
-	// Write output
-	if err := s.Write(addItemOutput); err != nil {
-		return fmt.Errorf("failed to write save: %w", err)
-	}
+		},
+	})
+}
 
-	// Verify written file
-	written, err := save.Load(addItemOutput)
+// checkItemQuantity applies the item database stacking rules and prints
+// any warnings (e.g. glitch items) before the save is touched
+func checkItemQuantity(itemID byte, qty int) error {
+	warnings, err := items.CheckQuantity(itemID, byte(qty))
 	if err != nil {
-		return fmt.Errorf("failed to verify written file: %w", err)
+		return err
 	}
-	if !written.ValidateChecksum() {
-		return fmt.Errorf("verification failed: checksum invalid after write")
+	for _, w := range warnings {
+		fmt.Printf("⚠️  %s\n", w)
 	}
-
-	newChecksum := s.GetChecksum()
-	fmt.Printf("\n✓ Save written: %s\n", addItemOutput)
-	fmt.Printf("✓ Checksum updated: 0x%02X → 0x%02X\n", oldChecksum, newChecksum)
-	fmt.Printf("✓ Verification passed\n")
-	fmt.Printf("\n🎉 Success! Your save is ready to use.")
-
 	return nil
 }
@@ -3,7 +3,7 @@ package main
 import (
 	"fmt"
 
-	"github.com/abravonunez/raracandy/internal/backup"
+	"github.com/abravonunez/raracandy/internal/edit"
 	"github.com/abravonunez/raracandy/internal/gen1/items"
 	"github.com/abravonunez/raracandy/internal/gen1/save"
 	"github.com/spf13/cobra"
@@ -56,11 +56,9 @@ func init() {
 }
 
 type itemChange struct {
-	name       string
-	itemID     byte
-	newQty     int
-	currentQty byte
-	isNew      bool
+	name   string
+	itemID byte
+	newQty int
 }
 
 func runAddItems(cmd *cobra.Command, args []string) error {
@@ -92,6 +90,9 @@ func runAddItems(cmd *cobra.Command, args []string) error {
 		if err != nil {
 			return fmt.Errorf("invalid item %d: %w", i+1, err)
 		}
+		if err := checkItemQuantity(itemID, addItemsQtys[i]); err != nil {
+			return fmt.Errorf("invalid item %d: %w", i+1, err)
+		}
 
 		changes = append(changes, itemChange{
 			name:   items.GetItemName(itemID),
@@ -100,120 +101,35 @@ func runAddItems(cmd *cobra.Command, args []string) error {
 		})
 	}
 
-	// Load save file
-	fmt.Println("⚙️  Loading save...")
-	s, err := save.Load(savePath)
-	if err != nil {
-		return fmt.Errorf("failed to load save: %w", err)
-	}
-
-	// Perform integrity check
-	fmt.Println("🔍 Running integrity check...")
-	report := s.CheckIntegrity()
-
-	if !report.IsValid {
-		fmt.Println("\n❌ Save file integrity check failed:")
-		for _, err := range report.Errors {
-			fmt.Printf("  • %s\n", err)
-		}
-		return fmt.Errorf("cannot modify corrupted save file")
-	}
-
-	fmt.Printf("✓ Integrity check passed\n")
-	fmt.Printf("✓ Detected: %s\n", report.GameVersion)
-	fmt.Println()
-
-	// Find current state for all items
-	for i := range changes {
-		currentIdx := items.FindItemIndex(s, changes[i].itemID)
-		if currentIdx >= 0 {
-			bagItems := items.GetBagItems(s)
-			changes[i].currentQty = bagItems[currentIdx].Quantity
-			changes[i].isNew = false
-		} else {
-			changes[i].currentQty = 0
-			changes[i].isNew = true
-		}
-	}
-
-	// Preview changes
-	fmt.Println("Changes to be applied:")
-	fmt.Println("  Bag items:")
-	for _, change := range changes {
-		if change.isNew {
-			fmt.Printf("    - %s: (new) → %d\n", change.name, change.newQty)
-		} else {
-			delta := change.newQty - int(change.currentQty)
-			fmt.Printf("    - %s: %d → %d (%+d)\n",
-				change.name, change.currentQty, change.newQty, delta)
-		}
-	}
-
-	oldChecksum := s.GetChecksum()
-	fmt.Printf("  Checksum: 0x%02X → (will recalculate)\n", oldChecksum)
-
-	if addItemsDryRun {
-		fmt.Println("\n[DRY RUN] No changes written")
-		return nil
-	}
-
-	// Ask for confirmation if not in force mode
-	if !addItemsForce {
-		changeList := make([]string, len(changes)+1)
-		for i, change := range changes {
-			if change.isNew {
-				changeList[i] = fmt.Sprintf("Add %s (qty: %d)", change.name, change.newQty)
-			} else {
-				changeList[i] = fmt.Sprintf("Update %s to quantity %d", change.name, change.newQty)
+	return runSaveEdit(saveEdit{
+		savePath: savePath,
+		output:   addItemsOutput,
+		dryRun:   addItemsDryRun,
+		force:    addItemsForce,
+		plan: func(tx *edit.Transaction) error {
+			fmt.Println("  Bag items:")
+			for _, change := range changes {
+				// Find the current state of the item
+				if currentIdx := items.FindItemIndex(tx.Save, change.itemID); currentIdx >= 0 {
+					currentQty := items.GetBagItems(tx.Save)[currentIdx].Quantity
+					delta := change.newQty - int(currentQty)
+					fmt.Printf("    - %s: %d → %d (%+d)\n",
+						change.name, currentQty, change.newQty, delta)
+					tx.Add(fmt.Sprintf("Update %s to quantity %d", change.name, change.newQty), change.apply)
+				} else {
+					fmt.Printf("    - %s: (new) → %d\n", change.name, change.newQty)
+					tx.Add(fmt.Sprintf("Add %s (qty: %d)", change.name, change.newQty), change.apply)
+				}
 			}
-		}
-		changeList[len(changes)] = "Recalculate checksum"
-
-		if !save.ConfirmWithDetails(changeList) {
-			fmt.Println("\n❌ Operation cancelled by user")
 			return nil
-		}
-	}
-
-	// Apply all changes
-	fmt.Println("\n✍️  Applying changes...")
-	for i, change := range changes {
-		if err := items.SetItemQuantity(s, change.itemID, byte(change.newQty)); err != nil {
-			return fmt.Errorf("failed to set item %d (%s): %w", i+1, change.name, err)
-		}
-	}
-
-	// Get original hash before backup
-	originalHash := s.GetSHA256()
-
-	// Create backup with hash
-	fmt.Println("💾 Creating backup...")
-	if err := backup.CreateBackupWithHash(savePath, originalHash); err != nil {
-		return fmt.Errorf("failed to create backup: %w", err)
-	}
-	fmt.Printf("✓ Backup created: %s\n", backup.GetBackupPath(savePath))
-	fmt.Printf("✓ Backup hash saved: %s.bak.sha256\n", savePath)
-
-	// Write output
-	if err := s.Write(addItemsOutput); err != nil {
-		return fmt.Errorf("failed to write save: %w", err)
-	}
+		},
+	})
+}
 
-	// Verify written file
-	written, err := save.Load(addItemsOutput)
-	if err != nil {
-		return fmt.Errorf("failed to verify written file: %w", err)
-	}
-	if !written.ValidateChecksum() {
-		return fmt.Errorf("verification failed: checksum invalid after write")
+// apply sets the item to its new quantity
+func (c itemChange) apply(s *save.Save) error {
+	if err := items.SetItemQuantity(s, c.itemID, byte(c.newQty)); err != nil {
+		return fmt.Errorf("failed to set item %s: %w", c.name, err)
 	}
-
-	newChecksum := s.GetChecksum()
-	fmt.Printf("\n✓ Save written: %s\n", addItemsOutput)
-	fmt.Printf("✓ Checksum updated: 0x%02X → 0x%02X\n", oldChecksum, newChecksum)
-	fmt.Printf("✓ Verification passed\n")
-	fmt.Printf("✓ %d item(s) added/updated\n", len(changes))
-	fmt.Printf("\n🎉 Success! Your save is ready to use.")
-
 	return nil
 }
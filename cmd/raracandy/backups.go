@@ -0,0 +1,169 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/abravonunez/raracandy/internal/backup"
+	"github.com/abravonunez/raracandy/internal/gen1/save"
+	"github.com/spf13/cobra"
+)
+
+var (
+	backupsKeep      int
+	backupsOlderThan string
+	backupsDryRun    bool
+	backupsForce     bool
+)
+
+var backupsCmd = &cobra.Command{
+	Use:   "backups",
+	Short: "Manage the backup history of a save",
+	Long: `Manage the snapshots kept in the .raracandy/backups directory next to a
+save. Every edit stores a snapshot of the save it is about to change, so
+earlier backups are never overwritten. The manifest.json in that directory
+records each snapshot's hash, game version, command and changes.
+
+Examples:
+  raracandy backups list pokemon.sav
+  raracandy backups prune pokemon.sav --keep 10
+  raracandy backups prune pokemon.sav --older-than 30d`,
+}
+
+var backupsListCmd = &cobra.Command{
+	Use:   "list <save-file>",
+	Short: "List the snapshots of a save",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runBackupsList,
+}
+
+var backupsPruneCmd = &cobra.Command{
+	Use:   "prune <save-file>",
+	Short: "Delete old snapshots of a save",
+	Long: `Delete snapshots of a save. With --keep only the N newest snapshots are
+kept; with --older-than only snapshots older than the given age (such as
+30d, 2w or 12h) are deleted. When both are given, a snapshot is deleted
+only if it is outside the newest N and older than the age.`,
+	Args: cobra.ExactArgs(1),
+	RunE: runBackupsPrune,
+}
+
+func init() {
+	rootCmd.AddCommand(backupsCmd)
+	backupsCmd.AddCommand(backupsListCmd, backupsPruneCmd)
+
+	backupsPruneCmd.Flags().IntVar(&backupsKeep, "keep", 0, "Number of newest snapshots to keep")
+	backupsPruneCmd.Flags().StringVar(&backupsOlderThan, "older-than", "", "Only delete snapshots older than this age (e.g., 30d, 2w, 12h)")
+	backupsPruneCmd.Flags().BoolVar(&backupsDryRun, "dry-run", false, "Show what would be deleted without deleting")
+	backupsPruneCmd.Flags().BoolVar(&backupsForce, "force", false, "Skip confirmation prompt")
+}
+
+// snapshotSave stores a snapshot of a save that is about to be modified
+func snapshotSave(savePath string, version fmt.Stringer, changes []string) error {
+	entry, err := backup.Snapshot(savePath, backup.SnapshotInfo{
+		GameVersion: version.String(),
+		Command:     commandPath,
+		Changes:     changes,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to store snapshot: %w", err)
+	}
+	fmt.Printf("✓ Snapshot stored: %s\n", entry.Path(savePath))
+	return nil
+}
+
+func runBackupsList(cmd *cobra.Command, args []string) error {
+	savePath := args[0]
+	m, err := backup.LoadManifest(savePath)
+	if err != nil {
+		return err
+	}
+
+	entries := m.For(savePath)
+	fmt.Printf("Snapshots of %s in %s (%d):\n", savePath, backup.StoreDir(savePath), len(entries))
+	if len(entries) == 0 {
+		fmt.Println("  (none)")
+	}
+	for _, e := range entries {
+		status := "✓"
+		if err := e.Verify(savePath); err != nil {
+			status = "✗ " + err.Error()
+		}
+		fmt.Printf("  %s  %s  %s\n", e.Created.Format("2006-01-02 15:04:05"), e.File, status)
+		fmt.Printf("      %s, %s\n", e.GameVersion, e.Command)
+		for _, change := range e.Changes {
+			fmt.Printf("      - %s\n", change)
+		}
+	}
+	return nil
+}
+
+func runBackupsPrune(cmd *cobra.Command, args []string) error {
+	savePath := args[0]
+	if backupsKeep < 0 {
+		return fmt.Errorf("--keep must not be negative")
+	}
+	filter := backup.PruneFilter{Keep: backupsKeep}
+	if backupsOlderThan != "" {
+		age, err := parseAge(backupsOlderThan)
+		if err != nil {
+			return err
+		}
+		filter.OlderThan = age
+	}
+	if filter.Keep == 0 && filter.OlderThan == 0 {
+		return fmt.Errorf("specify --keep and/or --older-than")
+	}
+
+	m, err := backup.LoadManifest(savePath)
+	if err != nil {
+		return err
+	}
+	entries := m.For(savePath)
+	remove := filter.Select(entries, time.Now())
+
+	fmt.Printf("Snapshots to delete (%d of %d):\n", len(remove), len(entries))
+	if len(remove) == 0 {
+		fmt.Println("  (none)")
+		return nil
+	}
+	for _, e := range remove {
+		fmt.Printf("  %s  %s\n", e.Created.Format("2006-01-02 15:04:05"), e.File)
+	}
+
+	if backupsDryRun {
+		fmt.Println("\n[DRY RUN] No snapshots deleted")
+		return nil
+	}
+
+	if !backupsForce {
+		if !save.ConfirmWithDetails([]string{fmt.Sprintf("Delete %d snapshot(s) of %s", len(remove), savePath)}) {
+			fmt.Println("\n❌ Operation cancelled by user")
+			return nil
+		}
+	}
+
+	if err := backup.Prune(savePath, remove); err != nil {
+		return err
+	}
+	fmt.Printf("✓ Deleted %d snapshot(s), %d kept\n", len(remove), len(entries)-len(remove))
+	return nil
+}
+
+// parseAge parses an age such as 30d or 2w, or any time.ParseDuration value
+func parseAge(s string) (time.Duration, error) {
+	units := map[string]time.Duration{"d": 24 * time.Hour, "w": 7 * 24 * time.Hour}
+	for suffix, unit := range units {
+		if n, ok := strings.CutSuffix(s, suffix); ok {
+			if v, err := strconv.Atoi(n); err == nil && v > 0 {
+				return time.Duration(v) * unit, nil
+			}
+		}
+	}
+	if d, err := time.ParseDuration(s); err == nil && d > 0 {
+		return d, nil
+	}
+	return 0, fmt.Errorf("invalid age %q (use e.g. 30d, 2w or 12h)", s)
+}
@@ -0,0 +1,148 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/abravonunez/raracandy/internal/edit"
+	"github.com/abravonunez/raracandy/internal/gen1/save"
+	"github.com/abravonunez/raracandy/internal/gen1/trainer"
+	"github.com/spf13/cobra"
+)
+
+var (
+	badgesOutput string
+	badgesDryRun bool
+	badgesForce  bool
+	badgesAll    bool
+	badgesNone   bool
+	badgesFlags  [trainer.BadgeCount]bool
+)
+
+var badgesCmd = &cobra.Command{
+	Use:   "badges",
+	Short: "Inspect and edit the gym badges",
+	Long: `Inspect and edit the eight gym badges.
+
+Examples:
+  raracandy badges show pokemon.sav
+  raracandy badges set pokemon.sav --boulder --cascade --out modified.sav
+  raracandy badges set pokemon.sav --cascade=false --out modified.sav
+  raracandy badges set pokemon.sav --all --out modified.sav`,
+}
+
+var badgesShowCmd = &cobra.Command{
+	Use:   "show <save-file>",
+	Short: "List the badges held",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runBadgesShow,
+}
+
+var badgesSetCmd = &cobra.Command{
+	Use:   "set <save-file>",
+	Short: "Give or take away badges",
+	Long: `Give badges with --boulder, --cascade, ... and take them away with
+--boulder=false. Badges that are not mentioned keep their current state;
+--all and --none start from every badge or from none.
+
+Only the badge bits are changed: gym leaders stay undefeated, so the
+integrity check warns about badges without the matching defeat flag.
+
+The save file will not be modified unless --out is specified.
+Use --dry-run to preview changes without writing.`,
+	Args: cobra.ExactArgs(1),
+	RunE: runBadgesSet,
+}
+
+func init() {
+	rootCmd.AddCommand(badgesCmd)
+	badgesCmd.AddCommand(badgesShowCmd, badgesSetCmd)
+
+	badgesSetCmd.Flags().StringVarP(&badgesOutput, "out", "o", "", "Output file path (required)")
+	badgesSetCmd.Flags().BoolVar(&badgesDryRun, "dry-run", false, "Preview changes without writing")
+	badgesSetCmd.Flags().BoolVar(&badgesForce, "force", false, "Skip confirmation prompt")
+	badgesSetCmd.Flags().BoolVar(&badgesAll, "all", false, "Start from all eight badges")
+	badgesSetCmd.Flags().BoolVar(&badgesNone, "none", false, "Start from no badges")
+	for _, badge := range trainer.AllBadges() {
+		name := strings.ToLower(badge.String())
+		badgesSetCmd.Flags().BoolVar(&badgesFlags[badge], name, false, fmt.Sprintf("%s Badge", badge))
+	}
+	badgesSetCmd.MarkFlagRequired("out")
+}
+
+func runBadgesShow(cmd *cobra.Command, args []string) error {
+	s, err := loadSave(args[0])
+	if err != nil {
+		return fmt.Errorf("failed to load save: %w", err)
+	}
+
+	badges := trainer.GetBadges(s)
+	fmt.Printf("Badges (%d/%d):\n", badges.Count(), trainer.BadgeCount)
+	for _, badge := range trainer.AllBadges() {
+		mark := "✗"
+		if badges.Has(badge) {
+			mark = "✓"
+		}
+		fmt.Printf("  %s %s Badge\n", mark, badge)
+	}
+	return nil
+}
+
+func runBadgesSet(cmd *cobra.Command, args []string) error {
+	if badgesAll && badgesNone {
+		return fmt.Errorf("--all and --none cannot be used together")
+	}
+	changed := badgesAll || badgesNone
+	for _, badge := range trainer.AllBadges() {
+		changed = changed || cmd.Flags().Changed(strings.ToLower(badge.String()))
+	}
+	if !changed {
+		return fmt.Errorf("at least one badge flag, --all or --none must be specified")
+	}
+
+	// newBadges applies the flags to the save's current badges
+	newBadges := func(current trainer.Badges) trainer.Badges {
+		switch {
+		case badgesAll:
+			current = 0xFF
+		case badgesNone:
+			current = 0
+		}
+		for _, badge := range trainer.AllBadges() {
+			if cmd.Flags().Changed(strings.ToLower(badge.String())) {
+				current = current.With(badge, badgesFlags[badge])
+			}
+		}
+		return current
+	}
+
+	return runSaveEdit(saveEdit{
+		savePath: args[0],
+		output:   badgesOutput,
+		dryRun:   badgesDryRun,
+		force:    badgesForce,
+		plan: func(tx *edit.Transaction) error {
+			current := trainer.GetBadges(tx.Save)
+			updated := newBadges(current)
+			fmt.Println("  Badges:")
+			for _, badge := range trainer.AllBadges() {
+				on := updated.Has(badge)
+				if current.Has(badge) == on {
+					continue
+				}
+				action := "Give"
+				if !on {
+					action = "Take away"
+				}
+				fmt.Printf("    - %s Badge: %s\n", badge, strings.ToLower(action))
+				tx.Add(fmt.Sprintf("%s the %s Badge", action, badge), func(s *save.Save) error {
+					return trainer.SetBadges(s, trainer.GetBadges(s).With(badge, on))
+				})
+			}
+			if len(tx.Changes()) == 0 {
+				fmt.Println("    - No change")
+			}
+			return nil
+		},
+	})
+}
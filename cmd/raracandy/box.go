@@ -0,0 +1,78 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/abravonunez/raracandy/internal/gen1/box"
+	"github.com/abravonunez/raracandy/internal/gen1/species"
+	"github.com/abravonunez/raracandy/internal/gen1/text"
+	"github.com/spf13/cobra"
+)
+
+var boxListNumber int
+
+var boxCmd = &cobra.Command{
+	Use:   "box",
+	Short: "Inspect Pokémon stored in the PC boxes",
+}
+
+var boxListCmd = &cobra.Command{
+	Use:   "list <save-file>",
+	Short: "List the Pokémon in the PC boxes",
+	Long: `List the Pokémon stored in the PC boxes.
+Shows every box unless --box is given.`,
+	Args: cobra.ExactArgs(1),
+	RunE: runBoxList,
+}
+
+func init() {
+	rootCmd.AddCommand(boxCmd)
+	boxCmd.AddCommand(boxListCmd)
+
+	boxListCmd.Flags().IntVar(&boxListNumber, "box", 0, "Only list this box (1-12, 1-8 on Japanese saves)")
+}
+
+func runBoxList(cmd *cobra.Command, args []string) error {
+	savePath := args[0]
+
+	s, err := loadSave(savePath)
+	if err != nil {
+		return fmt.Errorf("failed to load save: %w", err)
+	}
+
+	prof := s.GetProfile()
+	first, last := 0, prof.BoxCount-1
+	if boxListNumber != 0 {
+		if boxListNumber < 1 || boxListNumber > prof.BoxCount {
+			return fmt.Errorf("box must be between 1 and %d", prof.BoxCount)
+		}
+		first, last = boxListNumber-1, boxListNumber-1
+	}
+
+	if !s.BoxesInitialized() {
+		fmt.Println("Note: PC boxes are not initialized yet - only the current box holds data")
+		fmt.Println()
+	}
+
+	current := box.CurrentBox(s)
+	for b := first; b <= last; b++ {
+		mons, err := box.GetBox(s, b)
+		if err != nil {
+			return err
+		}
+
+		label := ""
+		if b == current {
+			label = " (current)"
+		}
+		fmt.Printf("Box %d%s (%d/%d):\n", b+1, label, len(mons), prof.MaxBoxMons)
+		if len(mons) == 0 {
+			fmt.Println("  (empty)")
+		}
+		for _, mon := range mons {
+			fmt.Printf("  - %s \"%s\" Lv. %d\n", species.GetName(mon.Species), text.Decode(mon.Nickname, prof.Charset), mon.BoxLevel)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,87 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/abravonunez/raracandy/internal/edit"
+	"github.com/abravonunez/raracandy/internal/gen1/money"
+	"github.com/abravonunez/raracandy/internal/gen1/save"
+	"github.com/spf13/cobra"
+)
+
+var (
+	setCoinsOutput string
+	setCoinsDryRun bool
+	setCoinsForce  bool
+	setCoinsAmount int
+)
+
+var getCoinsCmd = &cobra.Command{
+	Use:   "get-coins <save-file>",
+	Short: "Show the Game Corner coins",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runGetCoins,
+}
+
+var setCoinsCmd = &cobra.Command{
+	Use:   "set-coins <save-file>",
+	Short: "Set the Game Corner coins",
+	Long: `Set the number of Game Corner coins in the coin case.
+Coins are stored in BCD (Binary-Coded Decimal) format and can range from 0 to 9,999.
+
+The save file will not be modified unless --out is specified.
+Use --dry-run to preview changes without writing.
+
+Examples:
+  raracandy set-coins pokemon.sav --amount 9999 --out modified.sav`,
+	Args: cobra.ExactArgs(1),
+	RunE: runSetCoins,
+}
+
+func init() {
+	rootCmd.AddCommand(getCoinsCmd, setCoinsCmd)
+
+	setCoinsCmd.Flags().StringVarP(&setCoinsOutput, "out", "o", "", "Output file path (required)")
+	setCoinsCmd.Flags().BoolVar(&setCoinsDryRun, "dry-run", false, "Preview changes without writing")
+	setCoinsCmd.Flags().BoolVar(&setCoinsForce, "force", false, "Skip confirmation prompt")
+	setCoinsCmd.Flags().IntVar(&setCoinsAmount, "amount", 0, "Number of coins (0-9999)")
+
+	setCoinsCmd.MarkFlagRequired("out")
+	setCoinsCmd.MarkFlagRequired("amount")
+}
+
+func runGetCoins(cmd *cobra.Command, args []string) error {
+	s, err := loadSave(args[0])
+	if err != nil {
+		return fmt.Errorf("failed to load save: %w", err)
+	}
+
+	fmt.Printf("Coins: %s\n", money.FormatCoins(money.GetCoins(s)))
+	return nil
+}
+
+func runSetCoins(cmd *cobra.Command, args []string) error {
+	if setCoinsAmount < 0 || setCoinsAmount > money.MaxCoins {
+		return fmt.Errorf("amount must be between 0 and %d", money.MaxCoins)
+	}
+	amount := uint32(setCoinsAmount)
+
+	return runSaveEdit(saveEdit{
+		savePath: args[0],
+		output:   setCoinsOutput,
+		dryRun:   setCoinsDryRun,
+		force:    setCoinsForce,
+		plan: func(tx *edit.Transaction) error {
+			s := tx.Save
+			current := money.GetCoins(s)
+			fmt.Printf("  Coins: %s → %s (%+d)\n", money.FormatCoins(current), money.FormatCoins(amount), int(amount)-int(current))
+			tx.Add(fmt.Sprintf("Set coins to %s", money.FormatCoins(amount)), func(s *save.Save) error {
+				if err := money.SetCoins(s, amount); err != nil {
+					return fmt.Errorf("failed to set coins: %w", err)
+				}
+				return nil
+			})
+			return nil
+		},
+	})
+}
@@ -0,0 +1,173 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/abravonunez/raracandy/internal/edit"
+	"github.com/abravonunez/raracandy/internal/gen1/daycare"
+	"github.com/abravonunez/raracandy/internal/gen1/party"
+	"github.com/abravonunez/raracandy/internal/gen1/save"
+	"github.com/abravonunez/raracandy/internal/gen1/species"
+	"github.com/abravonunez/raracandy/internal/gen1/text"
+	"github.com/spf13/cobra"
+)
+
+var (
+	daycareOutput string
+	daycareDryRun bool
+	daycareForce  bool
+	daycareSlot   int
+)
+
+var daycareCmd = &cobra.Command{
+	Use:   "daycare",
+	Short: "Inspect the daycare and move Pokémon in or out",
+	Long: `Inspect the Route 5 daycare and move a Pokémon between it and the party.
+The Pokémon keeps its structure, nickname and OT name.
+
+Examples:
+  raracandy daycare show pokemon.sav
+  raracandy daycare deposit pokemon.sav --slot 2 --out modified.sav
+  raracandy daycare withdraw-to-party pokemon.sav --out modified.sav`,
+}
+
+var daycareShowCmd = &cobra.Command{
+	Use:   "show <save-file>",
+	Short: "Show the Pokémon in the daycare",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runDaycareShow,
+}
+
+var daycareWithdrawCmd = &cobra.Command{
+	Use:   "withdraw-to-party <save-file>",
+	Short: "Move the daycare Pokémon to the end of the party",
+	Long: `Move the daycare Pokémon to the end of the party. Its stats are
+recalculated for its level; the party must have a free slot.
+
+The save file will not be modified unless --out is specified.
+Use --dry-run to preview changes without writing.`,
+	Args: cobra.ExactArgs(1),
+	RunE: runDaycareWithdraw,
+}
+
+var daycareDepositCmd = &cobra.Command{
+	Use:   "deposit <save-file>",
+	Short: "Leave a party Pokémon at the daycare",
+	Long: `Move a party Pokémon into the empty daycare. The Pokémon after it move up
+one slot. The last Pokémon of the party cannot be deposited.
+
+The save file will not be modified unless --out is specified.
+Use --dry-run to preview changes without writing.`,
+	Args: cobra.ExactArgs(1),
+	RunE: runDaycareDeposit,
+}
+
+func init() {
+	rootCmd.AddCommand(daycareCmd)
+	daycareCmd.AddCommand(daycareShowCmd, daycareWithdrawCmd, daycareDepositCmd)
+
+	for _, cmd := range []*cobra.Command{daycareWithdrawCmd, daycareDepositCmd} {
+		cmd.Flags().StringVarP(&daycareOutput, "out", "o", "", "Output file path (required)")
+		cmd.Flags().BoolVar(&daycareDryRun, "dry-run", false, "Preview changes without writing")
+		cmd.Flags().BoolVar(&daycareForce, "force", false, "Skip confirmation prompt")
+		cmd.MarkFlagRequired("out")
+	}
+	daycareDepositCmd.Flags().IntVar(&daycareSlot, "slot", 0, "Party slot (1-6)")
+	daycareDepositCmd.MarkFlagRequired("slot")
+}
+
+func runDaycareShow(cmd *cobra.Command, args []string) error {
+	s, err := loadSave(args[0])
+	if err != nil {
+		return fmt.Errorf("failed to load save: %w", err)
+	}
+
+	fmt.Println("Daycare:")
+	mon, err := daycare.Get(s)
+	if err != nil {
+		fmt.Println("  (empty)")
+		return nil
+	}
+
+	charset := s.GetProfile().Charset
+	level := fmt.Sprintf("Lv. %d", daycare.GrownLevel(mon))
+	if daycare.GrownLevel(mon) != int(mon.BoxLevel) {
+		level += fmt.Sprintf(" (deposited at Lv. %d)", mon.BoxLevel)
+	}
+	fmt.Printf("  %s \"%s\"  %s  HP %d\n", species.GetName(mon.Species),
+		text.Decode(mon.Nickname, charset), level, mon.HP)
+	fmt.Printf("     OT:    %s (ID %05d)\n", text.Decode(mon.OTName, charset), mon.OTID)
+	fmt.Printf("     Exp:   %d\n", mon.Exp)
+	fmt.Printf("     Moves: %s\n", formatMoves(mon.Moves))
+	fmt.Printf("     DVs:   Atk %d  Def %d  Spd %d  Spc %d  (HP %d)\n",
+		mon.DVs.Attack, mon.DVs.Defense, mon.DVs.Speed, mon.DVs.Special, mon.DVs.HP())
+	return nil
+}
+
+func runDaycareWithdraw(cmd *cobra.Command, args []string) error {
+	return runSaveEdit(saveEdit{
+		savePath: args[0],
+		output:   daycareOutput,
+		dryRun:   daycareDryRun,
+		force:    daycareForce,
+		plan: func(tx *edit.Transaction) error {
+			s := tx.Save
+			mon, err := daycare.Get(s)
+			if err != nil {
+				return err
+			}
+			count := party.Count(s)
+			if count >= s.GetProfile().MaxPartyMons {
+				return fmt.Errorf("the party is full (%d Pokémon)", count)
+			}
+			name := text.Decode(mon.Nickname, s.GetProfile().Charset)
+			fmt.Printf("  Daycare: %s (%s, Lv. %d) → party slot %d\n", name, species.GetName(mon.Species), daycare.GrownLevel(mon), count+1)
+			fmt.Printf("  Party: %d → %d Pokémon\n", count, count+1)
+			tx.Add(fmt.Sprintf("Withdraw %s from the daycare into party slot %d", name, count+1), daycare.Withdraw)
+			return nil
+		},
+	})
+}
+
+func runDaycareDeposit(cmd *cobra.Command, args []string) error {
+	slot, err := daycareSlotIndex()
+	if err != nil {
+		return err
+	}
+
+	return runSaveEdit(saveEdit{
+		savePath: args[0],
+		output:   daycareOutput,
+		dryRun:   daycareDryRun,
+		force:    daycareForce,
+		plan: func(tx *edit.Transaction) error {
+			s := tx.Save
+			if daycare.InUse(s) {
+				return fmt.Errorf("the daycare is already raising a Pokémon")
+			}
+			mon, err := party.GetPartyMon(s, slot)
+			if err != nil {
+				return err
+			}
+			count := party.Count(s)
+			if count == 1 {
+				return fmt.Errorf("cannot deposit the only Pokémon in the party")
+			}
+			name := text.Decode(mon.Nickname, s.GetProfile().Charset)
+			fmt.Printf("  Party slot %d: %s (%s) → daycare\n", slot+1, name, species.GetName(mon.Species))
+			fmt.Printf("  Party: %d → %d Pokémon\n", count, count-1)
+			tx.Add(fmt.Sprintf("Deposit %s (slot %d) at the daycare", name, slot+1), func(s *save.Save) error {
+				return daycare.Deposit(s, slot)
+			})
+			return nil
+		},
+	})
+}
+
+// daycareSlotIndex validates the 1-based --slot flag and returns a 0-based index
+func daycareSlotIndex() (int, error) {
+	if daycareSlot < 1 || daycareSlot > 6 {
+		return 0, fmt.Errorf("slot must be between 1 and 6")
+	}
+	return daycareSlot - 1, nil
+}
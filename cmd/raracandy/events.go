@@ -0,0 +1,284 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/abravonunez/raracandy/internal/edit"
+	"github.com/abravonunez/raracandy/internal/gen1/save"
+	"github.com/spf13/cobra"
+)
+
+var (
+	eventsOutput  string
+	eventsDryRun  bool
+	eventsForce   bool
+	eventsFlags   []string
+	eventsPresets []string
+	eventsAll     bool
+)
+
+var eventsCmd = &cobra.Command{
+	Use:   "events",
+	Short: "Inspect and edit story event flags",
+	Long: `Inspect and edit the event flags that record story progress: gym
+leaders beaten, Snorlax woken up, legendary Pokémon battled, and so on.
+
+Flags are given by catalogue name (the EVENT_ prefix is optional) or by
+number. Presets name a group of flags; clearing one resets that part of
+the story. Run "events list" to see the catalogue and the presets.
+
+Examples:
+  raracandy events list pokemon.sav
+  raracandy events set pokemon.sav --flag EVENT_BEAT_BROCK --out modified.sav
+  raracandy events clear pokemon.sav --preset zapdos --out modified.sav
+  raracandy events clear pokemon.sav --flag 0x55D --out modified.sav`,
+}
+
+var eventsListCmd = &cobra.Command{
+	Use:   "list <save-file>",
+	Short: "List the named event flags and presets",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runEventsList,
+}
+
+var eventsSetCmd = &cobra.Command{
+	Use:   "set <save-file>",
+	Short: "Set event flags",
+	Long: `Set event flags given with --flag or --preset.
+
+Only the flags are changed: badges, items and Pokémon are left alone, so
+the integrity check may warn about the resulting combination. Encounter
+presets also hide the Pokémon's overworld sprite, as the battle does, and
+are refused when that sprite's hide/show flag is not known for the game.
+
+The save file will not be modified unless --out is specified.
+Use --dry-run to preview changes without writing.`,
+	Args: cobra.ExactArgs(1),
+	RunE: runEventsSet,
+}
+
+var eventsClearCmd = &cobra.Command{
+	Use:   "clear <save-file>",
+	Short: "Clear event flags",
+	Long: `Clear event flags given with --flag or --preset.
+
+Clearing an encounter preset (zapdos, snorlax, ...) also clears the
+hide/show flag of the Pokémon's overworld sprite, so it is back on the map
+and the battle can happen again. A preset whose hide/show flag is not yet
+known for the save's game (the legendary Pokémon in Yellow) is refused
+rather than half applied; its event flags can still be given with --flag.
+
+The save file will not be modified unless --out is specified.
+Use --dry-run to preview changes without writing.`,
+	Args: cobra.ExactArgs(1),
+	RunE: runEventsClear,
+}
+
+func init() {
+	rootCmd.AddCommand(eventsCmd)
+	eventsCmd.AddCommand(eventsListCmd, eventsSetCmd, eventsClearCmd)
+
+	eventsListCmd.Flags().BoolVar(&eventsAll, "all", false, "Also list set flags that are not in the catalogue")
+
+	for _, cmd := range []*cobra.Command{eventsSetCmd, eventsClearCmd} {
+		cmd.Flags().StringVarP(&eventsOutput, "out", "o", "", "Output file path (required)")
+		cmd.Flags().BoolVar(&eventsDryRun, "dry-run", false, "Preview changes without writing")
+		cmd.Flags().BoolVar(&eventsForce, "force", false, "Skip confirmation prompt")
+		cmd.Flags().StringSliceVar(&eventsFlags, "flag", nil, "Event flags by name or number, comma-separated (e.g., beat_brock,0x0BF)")
+		cmd.Flags().StringSliceVar(&eventsPresets, "preset", nil, "Presets, comma-separated (e.g., zapdos,snorlax)")
+		cmd.MarkFlagRequired("out")
+	}
+}
+
+func runEventsList(cmd *cobra.Command, args []string) error {
+	s, err := loadSave(args[0])
+	if err != nil {
+		return fmt.Errorf("failed to load save: %w", err)
+	}
+
+	set := s.EventFlagsSet()
+	fmt.Printf("Event flags (%d set):\n", len(set))
+	named := make(map[int]bool, len(save.EventCatalogue))
+	for _, e := range save.EventCatalogue {
+		named[e.Flag] = true
+		mark := "✗"
+		if s.EventFlag(e.Flag) {
+			mark = "✓"
+		}
+		fmt.Printf("  %s 0x%03X %-34s %s\n", mark, e.Flag, e.Name, e.Description)
+	}
+
+	if eventsAll {
+		fmt.Println()
+		fmt.Println("Other flags set:")
+		others := 0
+		for _, flag := range set {
+			if !named[flag] {
+				fmt.Printf("  ✓ 0x%03X\n", flag)
+				others++
+			}
+		}
+		if others == 0 {
+			fmt.Println("  (none)")
+		}
+	}
+
+	fmt.Println()
+	fmt.Println("Presets:")
+	for _, p := range save.EventPresets {
+		fmt.Printf("  %-16s %s\n", p.Name, p.Description)
+	}
+	return nil
+}
+
+func runEventsSet(cmd *cobra.Command, args []string) error {
+	return runEventsEdit(args[0], true)
+}
+
+func runEventsClear(cmd *cobra.Command, args []string) error {
+	return runEventsEdit(args[0], false)
+}
+
+// runEventsEdit sets or clears every flag named by --flag and --preset, and
+// hides or shows the sprites of the presets' objects to match
+func runEventsEdit(savePath string, on bool) error {
+	flags, presets, err := eventFlagArgs()
+	if err != nil {
+		return err
+	}
+	objects := presetObjects(presets)
+
+	action := "Clear"
+	if on {
+		action = "Set"
+	}
+
+	return runSaveEdit(saveEdit{
+		savePath: savePath,
+		output:   eventsOutput,
+		dryRun:   eventsDryRun,
+		force:    eventsForce,
+		plan: func(tx *edit.Transaction) error {
+			s := tx.Save
+			for _, p := range presets {
+				if err := s.CheckPreset(p); err != nil {
+					return err
+				}
+			}
+
+			names := make([]string, 0, len(flags))
+			fmt.Println("  Event flags:")
+			for _, flag := range flags {
+				name := save.EventFlagName(flag)
+				names = append(names, name)
+				if s.EventFlag(flag) == on {
+					fmt.Printf("    - %s: already %s\n", name, eventState(on))
+				} else {
+					fmt.Printf("    - %s: %s → %s\n", name, eventState(!on), eventState(on))
+				}
+			}
+			tx.Add(fmt.Sprintf("%s %s", action, strings.Join(names, ", ")), func(s *save.Save) error {
+				for _, flag := range flags {
+					if err := s.SetEventFlag(flag, on); err != nil {
+						return err
+					}
+				}
+				return nil
+			})
+
+			if len(objects) == 0 {
+				return nil
+			}
+			objectNames := make([]string, 0, len(objects))
+			fmt.Println("  Hide/show flags:")
+			for _, o := range objects {
+				if hidden, _ := s.ObjectHidden(o); hidden == on {
+					fmt.Printf("    - %s: already %s\n", o.Name, spriteState(on))
+				} else {
+					fmt.Printf("    - %s: %s → %s\n", o.Name, spriteState(!on), spriteState(on))
+				}
+				objectNames = append(objectNames, o.Name)
+			}
+			verb := "Show"
+			if on {
+				verb = "Hide"
+			}
+			tx.Add(fmt.Sprintf("%s the sprites of %s", verb, strings.Join(objectNames, ", ")), func(s *save.Save) error {
+				for _, o := range objects {
+					if err := s.SetObjectHidden(o, on); err != nil {
+						return err
+					}
+				}
+				return nil
+			})
+			return nil
+		},
+	})
+}
+
+// eventFlagArgs resolves --flag and --preset into a list of flags without
+// duplicates and the presets named
+func eventFlagArgs() ([]int, []save.EventPreset, error) {
+	if len(eventsFlags) == 0 && len(eventsPresets) == 0 {
+		return nil, nil, fmt.Errorf("at least one --flag or --preset must be specified")
+	}
+
+	flags := make([]int, 0, len(eventsFlags))
+	seen := make(map[int]bool)
+	add := func(flag int) {
+		if !seen[flag] {
+			seen[flag] = true
+			flags = append(flags, flag)
+		}
+	}
+
+	presets := make([]save.EventPreset, 0, len(eventsPresets))
+	for _, name := range eventsPresets {
+		preset, err := save.LookupEventPreset(name)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, flag := range preset.Flags {
+			add(flag)
+		}
+		presets = append(presets, preset)
+	}
+	for _, arg := range eventsFlags {
+		flag, err := save.LookupEventFlag(arg)
+		if err != nil {
+			return nil, nil, err
+		}
+		add(flag)
+	}
+	return flags, presets, nil
+}
+
+// presetObjects lists the objects of the presets without duplicates
+func presetObjects(presets []save.EventPreset) []save.MissableObject {
+	var objects []save.MissableObject
+	seen := make(map[string]bool)
+	for _, p := range presets {
+		for _, o := range p.Objects {
+			if !seen[o.Name] {
+				seen[o.Name] = true
+				objects = append(objects, o)
+			}
+		}
+	}
+	return objects
+}
+
+func eventState(on bool) string {
+	if on {
+		return "set"
+	}
+	return "clear"
+}
+
+func spriteState(hidden bool) string {
+	if hidden {
+		return "hidden"
+	}
+	return "shown"
+}
@@ -0,0 +1,45 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/abravonunez/raracandy/internal/gen1/export"
+	"github.com/abravonunez/raracandy/internal/output"
+	"github.com/spf13/cobra"
+)
+
+var exportCmd = &cobra.Command{
+	Use:   "export <save-file>",
+	Short: "Dump every decoded field of a save as JSON",
+	Long: `Write the trainer, money, options, Pokédex, bag, PC items, party and
+PC boxes of a save to standard output as JSON.
+
+The result can be edited and applied back with import. IDs are
+authoritative; the names next to them are only looked up when they no
+longer match the ID, so either one can be edited.
+
+Examples:
+  raracandy export pokemon.sav > pokemon.json`,
+	Args: cobra.ExactArgs(1),
+	RunE: runExport,
+}
+
+func init() {
+	rootCmd.AddCommand(exportCmd)
+}
+
+func runExport(cmd *cobra.Command, args []string) error {
+	cmd.SilenceUsage = true
+
+	s, err := loadSave(args[0])
+	if err != nil {
+		return fmt.Errorf("failed to load save: %w", err)
+	}
+
+	doc, err := export.Export(s)
+	if err != nil {
+		return fmt.Errorf("failed to export save: %w", err)
+	}
+	return output.Write(os.Stdout, output.FormatJSON, doc)
+}
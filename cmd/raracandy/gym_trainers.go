@@ -0,0 +1,118 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/abravonunez/raracandy/internal/edit"
+	"github.com/abravonunez/raracandy/internal/gen1/save"
+	"github.com/spf13/cobra"
+)
+
+var (
+	gymTrainersOutput  string
+	gymTrainersDryRun  bool
+	gymTrainersForce   bool
+	gymTrainersMaps    []string
+	gymTrainersAllGyms bool
+)
+
+var gymTrainersCmd = &cobra.Command{
+	Use:   "gym-trainers",
+	Short: "Inspect and reset the gym trainers already beaten",
+	Long: `Inspect and reset the event flags recording which gym trainers were
+beaten. Clearing a trainer's flag lets them challenge the player again.
+
+Only the trainers inside the eight gyms are covered: trainers on routes,
+in caves and in other buildings are not, and stay beaten. The gym leaders
+are story events and are reset with "events clear --preset gym-leaders".
+
+Examples:
+  raracandy gym-trainers list pokemon.sav
+  raracandy gym-trainers reset pokemon.sav --map cerulean-gym --out modified.sav
+  raracandy gym-trainers reset pokemon.sav --all-gyms --out modified.sav`,
+}
+
+var gymTrainersListCmd = &cobra.Command{
+	Use:   "list <save-file>",
+	Short: "List the gym trainers already beaten, by gym",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runGymTrainersList,
+}
+
+var gymTrainersResetCmd = &cobra.Command{
+	Use:   "reset <save-file>",
+	Short: "Let beaten gym trainers battle again",
+	Long: `Clear the trainer-defeated flags of the gyms given with --map, or with
+--all-gyms of all eight gyms. Trainers outside the gyms are not reset.
+
+The save file will not be modified unless --out is specified.
+Use --dry-run to preview changes without writing.`,
+	Args: cobra.ExactArgs(1),
+	RunE: runGymTrainersReset,
+}
+
+func init() {
+	rootCmd.AddCommand(gymTrainersCmd)
+	gymTrainersCmd.AddCommand(gymTrainersListCmd, gymTrainersResetCmd)
+
+	gymTrainersResetCmd.Flags().StringVarP(&gymTrainersOutput, "out", "o", "", "Output file path (required)")
+	gymTrainersResetCmd.Flags().BoolVar(&gymTrainersDryRun, "dry-run", false, "Preview changes without writing")
+	gymTrainersResetCmd.Flags().BoolVar(&gymTrainersForce, "force", false, "Skip confirmation prompt")
+	gymTrainersResetCmd.Flags().StringSliceVar(&gymTrainersMaps, "map", nil, "Gyms whose trainers to reset, comma-separated (e.g., pewter-gym,cerulean-gym)")
+	gymTrainersResetCmd.Flags().BoolVar(&gymTrainersAllGyms, "all-gyms", false, "Reset the trainers of all eight gyms")
+	gymTrainersResetCmd.MarkFlagRequired("out")
+}
+
+func runGymTrainersList(cmd *cobra.Command, args []string) error {
+	s, err := loadSave(args[0])
+	if err != nil {
+		return fmt.Errorf("failed to load save: %w", err)
+	}
+
+	events := s.EventFlags()
+	fmt.Println("Gym trainers beaten:")
+	for _, m := range save.GymTrainerMaps() {
+		fmt.Printf("  %-16s %d/%d\n", m.Name, countSet(s, events, m.GymTrainers), len(m.GymTrainers))
+	}
+	return nil
+}
+
+func runGymTrainersReset(cmd *cobra.Command, args []string) error {
+	if gymTrainersAllGyms && len(gymTrainersMaps) > 0 {
+		return fmt.Errorf("--all-gyms cannot be combined with --map")
+	}
+	if !gymTrainersAllGyms && len(gymTrainersMaps) == 0 {
+		return fmt.Errorf("specify --map or --all-gyms")
+	}
+
+	var maps []save.MapFlags
+	if gymTrainersAllGyms {
+		maps = save.GymTrainerMaps()
+	}
+	for _, name := range gymTrainersMaps {
+		m, err := save.LookupMap(name)
+		if err != nil {
+			return err
+		}
+		if len(m.GymTrainers) == 0 {
+			return fmt.Errorf("%s is not a gym (only gym trainers can be reset)", m.Name)
+		}
+		maps = append(maps, m)
+	}
+
+	return runSaveEdit(saveEdit{
+		savePath: args[0],
+		output:   gymTrainersOutput,
+		dryRun:   gymTrainersDryRun,
+		force:    gymTrainersForce,
+		plan: func(tx *edit.Transaction) error {
+			events := tx.Save.EventFlags()
+			resets := make([]flagReset, 0, len(maps))
+			for _, m := range maps {
+				resets = append(resets, newFlagReset("Beaten trainers in "+m.Name, events, m.GymTrainers))
+			}
+			planFlagResets(tx, resets)
+			return nil
+		},
+	})
+}
@@ -0,0 +1,219 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/abravonunez/raracandy/internal/edit"
+	"github.com/abravonunez/raracandy/internal/gen1/save"
+	"github.com/spf13/cobra"
+)
+
+var (
+	hiddenOutput  string
+	hiddenDryRun  bool
+	hiddenForce   bool
+	hiddenMaps    []string
+	hiddenIndexes []int
+	hiddenCoins   bool
+	hiddenAll     bool
+)
+
+var hiddenItemsCmd = &cobra.Command{
+	Use:   "hidden-items",
+	Short: "Inspect and reset the hidden items already found",
+	Long: `Inspect and reset the flags recording which hidden items (found with the
+Itemfinder) and Game Corner floor coins were already picked up. Clearing a
+flag lets the item be found again.
+
+Hidden items are numbered in the order of the game's hidden item table and
+can be selected by map; "hidden-items list" shows the map names.
+
+Examples:
+  raracandy hidden-items list pokemon.sav
+  raracandy hidden-items reset pokemon.sav --all --out modified.sav
+  raracandy hidden-items reset pokemon.sav --map route-17,mt-moon-b2f --out modified.sav
+  raracandy hidden-items reset pokemon.sav --index 0,1 --out modified.sav
+  raracandy hidden-items reset pokemon.sav --coins --out modified.sav`,
+}
+
+var hiddenItemsListCmd = &cobra.Command{
+	Use:   "list <save-file>",
+	Short: "List the hidden items and coins already found, by map",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runHiddenItemsList,
+}
+
+var hiddenItemsResetCmd = &cobra.Command{
+	Use:   "reset <save-file>",
+	Short: "Make hidden items findable again",
+	Long: `Clear the obtained flags of the hidden items on the maps given with --map,
+of the items given with --index, or with --all of every hidden item and
+Game Corner floor coin. --coins clears the Game Corner floor coins (as does
+--map game-corner).
+
+The save file will not be modified unless --out is specified.
+Use --dry-run to preview changes without writing.`,
+	Args: cobra.ExactArgs(1),
+	RunE: runHiddenItemsReset,
+}
+
+func init() {
+	rootCmd.AddCommand(hiddenItemsCmd)
+	hiddenItemsCmd.AddCommand(hiddenItemsListCmd, hiddenItemsResetCmd)
+
+	hiddenItemsResetCmd.Flags().StringVarP(&hiddenOutput, "out", "o", "", "Output file path (required)")
+	hiddenItemsResetCmd.Flags().BoolVar(&hiddenDryRun, "dry-run", false, "Preview changes without writing")
+	hiddenItemsResetCmd.Flags().BoolVar(&hiddenForce, "force", false, "Skip confirmation prompt")
+	hiddenItemsResetCmd.Flags().StringSliceVar(&hiddenMaps, "map", nil, "Maps whose hidden items to reset, comma-separated (e.g., route-17,game-corner)")
+	hiddenItemsResetCmd.Flags().IntSliceVar(&hiddenIndexes, "index", nil, "Hidden item numbers to reset, comma-separated")
+	hiddenItemsResetCmd.Flags().BoolVar(&hiddenCoins, "coins", false, "Reset the Game Corner floor coins")
+	hiddenItemsResetCmd.Flags().BoolVar(&hiddenAll, "all", false, "Reset every hidden item and floor coin")
+	hiddenItemsResetCmd.MarkFlagRequired("out")
+}
+
+func runHiddenItemsList(cmd *cobra.Command, args []string) error {
+	s, err := loadSave(args[0])
+	if err != nil {
+		return fmt.Errorf("failed to load save: %w", err)
+	}
+
+	items, coins := s.HiddenItemFlags(), s.HiddenCoinFlags()
+	for _, flags := range []save.FlagArray{items, coins} {
+		found := s.FlagsSet(flags)
+		fmt.Printf("Found %ss (%d/%d):\n", flags.Name, len(found), flags.Count)
+		if len(found) == 0 {
+			fmt.Println("  (none)")
+		}
+		for _, n := range found {
+			name := "game-corner"
+			if flags == items {
+				name = save.HiddenItemMap(n)
+			}
+			if name == "" {
+				name = "unknown map"
+			}
+			fmt.Printf("  - #%d (%s)\n", n, name)
+		}
+	}
+
+	fmt.Println()
+	fmt.Println("Maps:")
+	for _, m := range save.MapCatalogue {
+		switch {
+		case len(m.HiddenItems) > 0:
+			fmt.Printf("  %-30s %d/%d items found\n", m.Name, countSet(s, items, m.HiddenItems), len(m.HiddenItems))
+		case len(m.HiddenCoins) > 0:
+			fmt.Printf("  %-30s %d coins found\n", m.Name, countSet(s, coins, m.HiddenCoins))
+		}
+	}
+	return nil
+}
+
+func runHiddenItemsReset(cmd *cobra.Command, args []string) error {
+	if hiddenAll && (len(hiddenMaps) > 0 || len(hiddenIndexes) > 0 || hiddenCoins) {
+		return fmt.Errorf("--all cannot be combined with --map, --index or --coins")
+	}
+	if !hiddenAll && len(hiddenMaps) == 0 && len(hiddenIndexes) == 0 && !hiddenCoins {
+		return fmt.Errorf("specify --map, --index, --coins or --all")
+	}
+	for _, n := range hiddenIndexes {
+		if n < 0 || n >= save.MaxHiddenItems {
+			return fmt.Errorf("hidden item %d out of range (0-%d)", n, save.MaxHiddenItems-1)
+		}
+	}
+	itemFlags := append([]int(nil), hiddenIndexes...)
+	var coinFlags []int
+	for _, name := range hiddenMaps {
+		m, err := save.LookupMap(name)
+		if err != nil {
+			return err
+		}
+		if len(m.HiddenItems) == 0 && len(m.HiddenCoins) == 0 {
+			return fmt.Errorf("%s has no hidden items", m.Name)
+		}
+		itemFlags = append(itemFlags, m.HiddenItems...)
+		coinFlags = append(coinFlags, m.HiddenCoins...)
+	}
+	if hiddenAll {
+		itemFlags = allFlags(save.MaxHiddenItems)
+	}
+	if hiddenAll || hiddenCoins {
+		coinFlags = allFlags(save.MaxHiddenCoins)
+	}
+
+	return runSaveEdit(saveEdit{
+		savePath: args[0],
+		output:   hiddenOutput,
+		dryRun:   hiddenDryRun,
+		force:    hiddenForce,
+		plan: func(tx *edit.Transaction) error {
+			s := tx.Save
+			var resets []flagReset
+			if len(itemFlags) > 0 {
+				resets = append(resets, newFlagReset("Found hidden items", s.HiddenItemFlags(), itemFlags))
+			}
+			if len(coinFlags) > 0 {
+				resets = append(resets, newFlagReset("Found hidden coins", s.HiddenCoinFlags(), coinFlags))
+			}
+			planFlagResets(tx, resets)
+			return nil
+		},
+	})
+}
+
+// flagReset is a set of flags to clear in one flag array
+type flagReset struct {
+	label string // what a set flag means, such as "Beaten trainers"
+	flags save.FlagArray
+	clear []int
+}
+
+// newFlagReset builds a flagReset, dropping flags listed twice
+func newFlagReset(label string, flags save.FlagArray, clear []int) flagReset {
+	seen := make(map[int]bool, len(clear))
+	unique := make([]int, 0, len(clear))
+	for _, n := range clear {
+		if !seen[n] {
+			seen[n] = true
+			unique = append(unique, n)
+		}
+	}
+	return flagReset{label, flags, unique}
+}
+
+// planFlagResets previews the resets and registers them with the
+// transaction
+func planFlagResets(tx *edit.Transaction, resets []flagReset) {
+	for _, r := range resets {
+		set := countSet(tx.Save, r.flags, r.clear)
+		fmt.Printf("  %s: %d of %d selected → 0\n", r.label, set, len(r.clear))
+		tx.Add(fmt.Sprintf("Reset %d %s flag(s) (%s)", set, r.flags.Name, r.label), func(s *save.Save) error {
+			for _, n := range r.clear {
+				if err := s.SetFlag(r.flags, n, false); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+	}
+}
+
+// allFlags returns every flag number of an array of count flags
+func allFlags(count int) []int {
+	flags := make([]int, count)
+	for i := range flags {
+		flags[i] = i
+	}
+	return flags
+}
+
+// countSet returns how many of the given flags are set
+func countSet(s *save.Save, a save.FlagArray, flags []int) int {
+	n := 0
+	for _, flag := range flags {
+		if s.Flag(a, flag) {
+			n++
+		}
+	}
+	return n
+}
@@ -0,0 +1,93 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/abravonunez/raracandy/internal/edit"
+	"github.com/abravonunez/raracandy/internal/gen1/halloffame"
+	"github.com/abravonunez/raracandy/internal/gen1/species"
+	"github.com/abravonunez/raracandy/internal/gen1/text"
+	"github.com/spf13/cobra"
+)
+
+var (
+	hofOutput string
+	hofDryRun bool
+	hofForce  bool
+)
+
+var hofCmd = &cobra.Command{
+	Use:   "hof",
+	Short: "Inspect and clear the Hall of Fame",
+	Long: `Inspect and clear the Hall of Fame records kept in SRAM bank 0 (the
+last 50 teams that beat the Elite Four).
+
+Examples:
+  raracandy hof list pokemon.sav
+  raracandy hof clear pokemon.sav --out modified.sav`,
+}
+
+var hofListCmd = &cobra.Command{
+	Use:   "list <save-file>",
+	Short: "List the Hall of Fame teams",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runHofList,
+}
+
+var hofClearCmd = &cobra.Command{
+	Use:   "clear <save-file>",
+	Short: "Erase every Hall of Fame team",
+	Long: `Erase every Hall of Fame team and reset the induction count.
+
+The save file will not be modified unless --out is specified.
+Use --dry-run to preview changes without writing.`,
+	Args: cobra.ExactArgs(1),
+	RunE: runHofClear,
+}
+
+func init() {
+	rootCmd.AddCommand(hofCmd)
+	hofCmd.AddCommand(hofListCmd, hofClearCmd)
+
+	hofClearCmd.Flags().StringVarP(&hofOutput, "out", "o", "", "Output file path (required)")
+	hofClearCmd.Flags().BoolVar(&hofDryRun, "dry-run", false, "Preview changes without writing")
+	hofClearCmd.Flags().BoolVar(&hofForce, "force", false, "Skip confirmation prompt")
+	hofClearCmd.MarkFlagRequired("out")
+}
+
+func runHofList(cmd *cobra.Command, args []string) error {
+	s, err := loadSave(args[0])
+	if err != nil {
+		return fmt.Errorf("failed to load save: %w", err)
+	}
+
+	teams := halloffame.Teams(s)
+	fmt.Printf("Hall of Fame (%d inducted, %d kept):\n", halloffame.Inducted(s), len(teams))
+	if len(teams) == 0 {
+		fmt.Println("  (empty)")
+	}
+
+	charset := s.GetProfile().Charset
+	for _, team := range teams {
+		fmt.Printf("  Team %d:\n", team.Number)
+		for _, mon := range team.Mons {
+			fmt.Printf("    - %s \"%s\" Lv. %d\n", species.GetName(mon.Species), text.Decode(mon.Nickname, charset), mon.Level)
+		}
+	}
+	return nil
+}
+
+func runHofClear(cmd *cobra.Command, args []string) error {
+	return runSaveEdit(saveEdit{
+		savePath: args[0],
+		output:   hofOutput,
+		dryRun:   hofDryRun,
+		force:    hofForce,
+		plan: func(tx *edit.Transaction) error {
+			s := tx.Save
+			fmt.Printf("  Hall of Fame: %d team(s) kept, %d inducted → 0\n", len(halloffame.Teams(s)), halloffame.Inducted(s))
+			tx.Add("Clear the Hall of Fame", halloffame.Clear)
+			return nil
+		},
+	})
+}
@@ -0,0 +1,109 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/abravonunez/raracandy/internal/edit"
+	"github.com/abravonunez/raracandy/internal/gen1/export"
+	"github.com/abravonunez/raracandy/internal/gen1/save"
+	"github.com/spf13/cobra"
+)
+
+var (
+	importBase   string
+	importOutput string
+	importDryRun bool
+	importForce  bool
+)
+
+var importCmd = &cobra.Command{
+	Use:   "import <json-file>",
+	Short: "Apply an edited export back onto a save",
+	Long: `Apply a JSON document produced by export onto a base save.
+Only the sections that differ from the base save are written; importing
+an unedited export produces a byte-identical save. The integrity check is
+run again on the result before it is written.
+
+The save file will not be modified unless --out is specified.
+Use --dry-run to preview changes without writing.
+
+Examples:
+  raracandy export pokemon.sav > pokemon.json
+  raracandy import pokemon.json --base pokemon.sav --out modified.sav`,
+	Args: cobra.ExactArgs(1),
+	RunE: runImport,
+}
+
+func init() {
+	rootCmd.AddCommand(importCmd)
+
+	importCmd.Flags().StringVar(&importBase, "base", "", "Save file to apply the document onto (required)")
+	importCmd.Flags().StringVarP(&importOutput, "out", "o", "", "Output file path (required)")
+	importCmd.Flags().BoolVar(&importDryRun, "dry-run", false, "Preview changes without writing")
+	importCmd.Flags().BoolVar(&importForce, "force", false, "Skip confirmation prompt")
+
+	importCmd.MarkFlagRequired("base")
+	importCmd.MarkFlagRequired("out")
+}
+
+func runImport(cmd *cobra.Command, args []string) error {
+	doc, err := readExport(args[0])
+	if err != nil {
+		return err
+	}
+
+	return runSaveEdit(saveEdit{
+		savePath: importBase,
+		output:   importOutput,
+		dryRun:   importDryRun,
+		force:    importForce,
+		plan: func(tx *edit.Transaction) error {
+			changes, err := export.Changes(tx.Save, doc)
+			if err != nil {
+				return fmt.Errorf("cannot import %s: %w", args[0], err)
+			}
+			fmt.Println("  Import:")
+			if len(changes) == 0 {
+				fmt.Println("    - No differences from the base save")
+			}
+			for _, change := range changes {
+				fmt.Printf("    - %s\n", change)
+			}
+
+			// The document is applied as a whole, so that its integrity is
+			// checked once every section has been written
+			tx.Add(fmt.Sprintf("Import %s (%d change(s))", args[0], len(changes)), func(s *save.Save) error {
+				report, err := export.Apply(s, doc)
+				if err != nil {
+					return fmt.Errorf("failed to import: %w", err)
+				}
+				for _, warning := range report.Warnings {
+					fmt.Printf("⚠️  %s\n", warning)
+				}
+				return nil
+			})
+			return nil
+		},
+	})
+}
+
+// readExport parses an export document, rejecting unknown fields so that
+// typos in hand-edited files are not silently ignored
+func readExport(path string) (*export.Document, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+	defer f.Close()
+
+	dec := json.NewDecoder(f)
+	dec.DisallowUnknownFields()
+
+	var doc export.Document
+	if err := dec.Decode(&doc); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+	return &doc, nil
+}
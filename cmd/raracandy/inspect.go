@@ -2,10 +2,19 @@ package main
 
 import (
 	"fmt"
+	"os"
+	"strings"
 
+	"github.com/abravonunez/raracandy/internal/gen1/daycare"
 	"github.com/abravonunez/raracandy/internal/gen1/items"
 	"github.com/abravonunez/raracandy/internal/gen1/money"
-	"github.com/abravonunez/raracandy/internal/gen1/save"
+	"github.com/abravonunez/raracandy/internal/gen1/options"
+	"github.com/abravonunez/raracandy/internal/gen1/pikachu"
+	"github.com/abravonunez/raracandy/internal/gen1/pokedex"
+	"github.com/abravonunez/raracandy/internal/gen1/species"
+	"github.com/abravonunez/raracandy/internal/gen1/text"
+	"github.com/abravonunez/raracandy/internal/gen1/trainer"
+	"github.com/abravonunez/raracandy/internal/output"
 	"github.com/spf13/cobra"
 )
 
@@ -19,17 +28,29 @@ var inspectCmd = &cobra.Command{
 
 func init() {
 	yellowCmd.AddCommand(inspectCmd)
+	addOutputFlag(inspectCmd)
 }
 
 func runInspect(cmd *cobra.Command, args []string) error {
 	savePath := args[0]
 
+	format, err := output.ParseFormat(outputFormat)
+	if err != nil {
+		return err
+	}
+	// Arguments are valid from here on; failures should not print usage
+	cmd.SilenceUsage = true
+
 	// Load save file
-	s, err := save.Load(savePath)
+	s, err := loadSave(savePath)
 	if err != nil {
 		return fmt.Errorf("failed to load save: %w", err)
 	}
 
+	if format != output.FormatText {
+		return output.Write(os.Stdout, format, buildSaveReport(savePath, s, s.CheckIntegrity()))
+	}
+
 	fmt.Printf("Save File: %s\n", savePath)
 	fmt.Printf("Size: %d KB\n", len(s.Data())/1024)
 	fmt.Println()
@@ -49,14 +70,40 @@ func runInspect(cmd *cobra.Command, args []string) error {
 	}
 	fmt.Println()
 
-	// Money
-	playerMoney := money.GetMoney(s)
-	fmt.Printf("Money: %s\n", money.FormatMoney(playerMoney))
+	// Trainer card
+	badges := trainer.GetBadges(s)
+	badgeNames := badgeReports(badges)
+	if len(badgeNames) == 0 {
+		badgeNames = append(badgeNames, "none")
+	}
+
+	fmt.Println("Trainer Card:")
+	fmt.Printf("  Name:      %s\n", trainer.GetPlayerName(s))
+	fmt.Printf("  ID No.:    %05d\n", trainer.GetID(s))
+	fmt.Printf("  Money:     %s\n", money.FormatMoney(money.GetMoney(s)))
+	fmt.Printf("  Coins:     %s\n", money.FormatCoins(money.GetCoins(s)))
+	fmt.Printf("  Pokédex:   %d owned, %d seen\n", pokedex.GetOwned(s).Count(), pokedex.GetSeen(s).Count())
+	fmt.Printf("  Play Time: %s\n", trainer.GetPlayTime(s))
+	fmt.Printf("  Badges:    %s (%d/%d)\n", strings.Join(badgeNames, ", "), badges.Count(), trainer.BadgeCount)
+	fmt.Printf("  Rival:     %s\n", trainer.GetRivalName(s))
+	if friendship, err := pikachu.GetFriendship(s); err == nil {
+		fmt.Printf("  Pikachu:   %d/%d friendship (%s)\n", friendship, pikachu.MaxFriendship, pikachu.MoodFor(friendship))
+	}
+	fmt.Println()
+
+	fmt.Printf("Options: %s\n", options.Get(s))
+	fmt.Println()
+
+	if mon, err := daycare.Get(s); err == nil {
+		fmt.Printf("Daycare: %s \"%s\" Lv. %d\n", species.GetName(mon.Species), text.Decode(mon.Nickname, s.GetProfile().Charset), mon.BoxLevel)
+	} else {
+		fmt.Println("Daycare: (empty)")
+	}
 	fmt.Println()
 
 	// Bag items
 	bagItems := items.GetBagItems(s)
-	fmt.Printf("Bag (%d/%d items):\n", len(bagItems), items.MaxBagItems)
+	fmt.Printf("Bag (%d/%d items):\n", len(bagItems), s.GetProfile().MaxBagItems)
 	if len(bagItems) == 0 {
 		fmt.Println("  (empty)")
 	} else {
@@ -64,6 +111,18 @@ func runInspect(cmd *cobra.Command, args []string) error {
 			fmt.Printf("  - %s x%d\n", item.Name, item.Quantity)
 		}
 	}
+	fmt.Println()
+
+	// PC items
+	pcItems := items.GetItems(s, items.PCBox(s))
+	fmt.Printf("PC Items (%d/%d items):\n", len(pcItems), s.GetProfile().MaxPCItems)
+	if len(pcItems) == 0 {
+		fmt.Println("  (empty)")
+	} else {
+		for _, item := range pcItems {
+			fmt.Printf("  - %s x%d\n", item.Name, item.Quantity)
+		}
+	}
 
 	return nil
 }
@@ -0,0 +1,149 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/abravonunez/raracandy/internal/edit"
+	"github.com/abravonunez/raracandy/internal/gen1/options"
+	"github.com/abravonunez/raracandy/internal/gen1/profile"
+	"github.com/abravonunez/raracandy/internal/gen1/save"
+	"github.com/spf13/cobra"
+)
+
+var (
+	optionsOutput      string
+	optionsDryRun      bool
+	optionsForce       bool
+	optionsTextSpeed   string
+	optionsAnimations  string
+	optionsBattleStyle string
+	optionsSound       string
+)
+
+var optionsCmd = &cobra.Command{
+	Use:   "options <save-file>",
+	Short: "Show or change the game options",
+	Long: `Show or change the options menu settings: text speed, battle animations,
+battle style and, in Pokémon Yellow, the sound mode.
+
+Without any setting flag the current options are shown. Settings that are
+not given keep their current value.
+
+The save file will not be modified unless --out is specified.
+Use --dry-run to preview changes without writing.
+
+Examples:
+  raracandy options pokemon.sav
+  raracandy options pokemon.sav --text-speed fast --battle-style set --animations off --out modified.sav
+  raracandy options pokemon.sav --sound earphone1 --out modified.sav`,
+	Args: cobra.ExactArgs(1),
+	RunE: runOptions,
+}
+
+func init() {
+	rootCmd.AddCommand(optionsCmd)
+
+	optionsCmd.Flags().StringVarP(&optionsOutput, "out", "o", "", "Output file path (required when changing options)")
+	optionsCmd.Flags().BoolVar(&optionsDryRun, "dry-run", false, "Preview changes without writing")
+	optionsCmd.Flags().BoolVar(&optionsForce, "force", false, "Skip confirmation prompt")
+	optionsCmd.Flags().StringVar(&optionsTextSpeed, "text-speed", "", "Text speed (fast, medium, slow)")
+	optionsCmd.Flags().StringVar(&optionsAnimations, "animations", "", "Battle animations (on, off)")
+	optionsCmd.Flags().StringVar(&optionsBattleStyle, "battle-style", "", "Battle style (shift, set)")
+	optionsCmd.Flags().StringVar(&optionsSound, "sound", "", "Sound, Yellow only (mono, earphone1, earphone2, earphone3)")
+}
+
+func runOptions(cmd *cobra.Command, args []string) error {
+	// Each setting flag becomes an edit of the current options
+	var edits []func(*options.Options)
+
+	if optionsTextSpeed != "" {
+		speed, err := options.ParseTextSpeed(optionsTextSpeed)
+		if err != nil {
+			return err
+		}
+		edits = append(edits, func(o *options.Options) { o.TextSpeed = speed })
+	}
+	if optionsAnimations != "" {
+		var on bool
+		switch strings.ToLower(optionsAnimations) {
+		case "on":
+			on = true
+		case "off":
+		default:
+			return fmt.Errorf("unknown animations setting %q (expected on or off)", optionsAnimations)
+		}
+		edits = append(edits, func(o *options.Options) { o.Animations = on })
+	}
+	if optionsBattleStyle != "" {
+		style, err := options.ParseBattleStyle(optionsBattleStyle)
+		if err != nil {
+			return err
+		}
+		edits = append(edits, func(o *options.Options) { o.BattleStyle = style })
+	}
+	if optionsSound != "" {
+		sound, err := options.ParseSound(optionsSound)
+		if err != nil {
+			return err
+		}
+		edits = append(edits, func(o *options.Options) { o.Sound = sound })
+	}
+
+	if len(edits) == 0 {
+		return showOptions(args[0])
+	}
+	if optionsOutput == "" {
+		return fmt.Errorf("--out is required when changing options")
+	}
+
+	applyEdits := func(o options.Options) options.Options {
+		for _, e := range edits {
+			e(&o)
+		}
+		return o
+	}
+
+	return runSaveEdit(saveEdit{
+		savePath: args[0],
+		output:   optionsOutput,
+		dryRun:   optionsDryRun,
+		force:    optionsForce,
+		plan: func(tx *edit.Transaction) error {
+			s := tx.Save
+			current := options.Get(s)
+			updated := applyEdits(current)
+			if err := updated.ValidateChanges(current, s.GetProfile().Version.Game()); err != nil {
+				return err
+			}
+			fmt.Printf("  Options: %s\n", current)
+			fmt.Printf("        → %s\n", updated)
+			tx.Add(fmt.Sprintf("Set options to %s", updated), func(s *save.Save) error {
+				return options.Set(s, applyEdits(options.Get(s)))
+			})
+			return nil
+		},
+	})
+}
+
+func showOptions(savePath string) error {
+	s, err := loadSave(savePath)
+	if err != nil {
+		return fmt.Errorf("failed to load save: %w", err)
+	}
+
+	o := options.Get(s)
+	animations := "on"
+	if !o.Animations {
+		animations = "off"
+	}
+
+	fmt.Println("Options:")
+	fmt.Printf("  Text speed:   %s\n", o.TextSpeed)
+	fmt.Printf("  Animations:   %s\n", animations)
+	fmt.Printf("  Battle style: %s\n", o.BattleStyle)
+	if s.GetProfile().Version.Game() != profile.GameRedBlue {
+		fmt.Printf("  Sound:        %s\n", o.Sound)
+	}
+	return nil
+}
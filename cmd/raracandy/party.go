@@ -0,0 +1,299 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/abravonunez/raracandy/internal/edit"
+	"github.com/abravonunez/raracandy/internal/gen1/moves"
+	"github.com/abravonunez/raracandy/internal/gen1/party"
+	"github.com/abravonunez/raracandy/internal/gen1/save"
+	"github.com/abravonunez/raracandy/internal/gen1/species"
+	"github.com/abravonunez/raracandy/internal/gen1/text"
+	"github.com/spf13/cobra"
+)
+
+var (
+	partyOutput string
+	partyDryRun bool
+	partyForce  bool
+	partySlot   int
+	partyLevel  int
+	partyMoves  []string
+	partyDVAtk  int
+	partyDVDef  int
+	partyDVSpd  int
+	partyDVSpc  int
+)
+
+var partyCmd = &cobra.Command{
+	Use:   "party",
+	Short: "Inspect and edit Pokémon in the party",
+	Long: `Inspect and edit the Pokémon in your party.
+
+Examples:
+  raracandy party list pokemon.sav
+  raracandy party set-level pokemon.sav --slot 1 --level 50 --out modified.sav
+  raracandy party set-moves pokemon.sav --slot 1 --move thunderbolt --move surf --out modified.sav
+  raracandy party set-dvs pokemon.sav --slot 1 --atk 15 --def 15 --spd 15 --spc 15 --out modified.sav`,
+}
+
+var partyListCmd = &cobra.Command{
+	Use:   "list <save-file>",
+	Short: "List the Pokémon in the party",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runPartyList,
+}
+
+var partySetLevelCmd = &cobra.Command{
+	Use:   "set-level <save-file>",
+	Short: "Set the level of a party Pokémon",
+	Long: `Set the level of a party Pokémon (1-100).
+Experience is set to the minimum for the new level, stats are recalculated
+and HP is fully restored.
+
+The save file will not be modified unless --out is specified.
+Use --dry-run to preview changes without writing.`,
+	Args: cobra.ExactArgs(1),
+	RunE: runPartySetLevel,
+}
+
+var partySetMovesCmd = &cobra.Command{
+	Use:   "set-moves <save-file>",
+	Short: "Set the moves of a party Pokémon",
+	Long: `Replace the moves of a party Pokémon. Pass --move up to four times;
+remaining slots are left empty. Changed moves get full base PP.
+
+The save file will not be modified unless --out is specified.
+Use --dry-run to preview changes without writing.`,
+	Args: cobra.ExactArgs(1),
+	RunE: runPartySetMoves,
+}
+
+var partySetDVsCmd = &cobra.Command{
+	Use:   "set-dvs <save-file>",
+	Short: "Set the DVs (IVs) of a party Pokémon",
+	Long: `Set the determinant values (0-15) of a party Pokémon and recalculate its stats.
+DVs that are not specified keep their current value. The HP DV is derived
+from the other four, as in the game.
+
+The save file will not be modified unless --out is specified.
+Use --dry-run to preview changes without writing.`,
+	Args: cobra.ExactArgs(1),
+	RunE: runPartySetDVs,
+}
+
+func init() {
+	rootCmd.AddCommand(partyCmd)
+	partyCmd.AddCommand(partyListCmd, partySetLevelCmd, partySetMovesCmd, partySetDVsCmd)
+
+	for _, cmd := range []*cobra.Command{partySetLevelCmd, partySetMovesCmd, partySetDVsCmd} {
+		cmd.Flags().StringVarP(&partyOutput, "out", "o", "", "Output file path (required)")
+		cmd.Flags().BoolVar(&partyDryRun, "dry-run", false, "Preview changes without writing")
+		cmd.Flags().BoolVar(&partyForce, "force", false, "Skip confirmation prompt")
+		cmd.Flags().IntVar(&partySlot, "slot", 0, "Party slot (1-6)")
+		cmd.MarkFlagRequired("out")
+		cmd.MarkFlagRequired("slot")
+	}
+
+	partySetLevelCmd.Flags().IntVar(&partyLevel, "level", 0, "New level (1-100)")
+	partySetLevelCmd.MarkFlagRequired("level")
+
+	partySetMovesCmd.Flags().StringSliceVar(&partyMoves, "move", []string{}, "Move name (can be repeated, up to 4)")
+	partySetMovesCmd.MarkFlagRequired("move")
+
+	partySetDVsCmd.Flags().IntVar(&partyDVAtk, "atk", -1, "Attack DV (0-15)")
+	partySetDVsCmd.Flags().IntVar(&partyDVDef, "def", -1, "Defense DV (0-15)")
+	partySetDVsCmd.Flags().IntVar(&partyDVSpd, "spd", -1, "Speed DV (0-15)")
+	partySetDVsCmd.Flags().IntVar(&partyDVSpc, "spc", -1, "Special DV (0-15)")
+}
+
+func runPartyList(cmd *cobra.Command, args []string) error {
+	savePath := args[0]
+
+	s, err := loadSave(savePath)
+	if err != nil {
+		return fmt.Errorf("failed to load save: %w", err)
+	}
+
+	mons := party.GetParty(s)
+	fmt.Printf("Party (%d/%d):\n", len(mons), s.GetProfile().MaxPartyMons)
+	if len(mons) == 0 {
+		fmt.Println("  (empty)")
+		return nil
+	}
+
+	for i, mon := range mons {
+		printPartyMon(s, i, mon)
+	}
+
+	return nil
+}
+
+func printPartyMon(s *save.Save, slot int, mon party.PartyMon) {
+	charset := s.GetProfile().Charset
+	fmt.Printf("  %d. %s \"%s\"  Lv. %d  HP %d/%d\n", slot+1, species.GetName(mon.Species),
+		text.Decode(mon.Nickname, charset), mon.Level, mon.HP, mon.Stats.HP)
+	fmt.Printf("     OT:    %s\n", text.Decode(mon.OTName, charset))
+	fmt.Printf("     Moves: %s\n", formatMoves(mon.Moves))
+	fmt.Printf("     Stats: Atk %d  Def %d  Spd %d  Spc %d\n",
+		mon.Stats.Attack, mon.Stats.Defense, mon.Stats.Speed, mon.Stats.Special)
+	fmt.Printf("     DVs:   Atk %d  Def %d  Spd %d  Spc %d  (HP %d)\n",
+		mon.DVs.Attack, mon.DVs.Defense, mon.DVs.Speed, mon.DVs.Special, mon.DVs.HP())
+}
+
+func formatMoves(ids [4]byte) string {
+	out := ""
+	for i, id := range ids {
+		if i > 0 {
+			out += ", "
+		}
+		out += moves.GetMoveName(id)
+	}
+	return out
+}
+
+// partySlotIndex validates the 1-based --slot flag and returns a 0-based index
+func partySlotIndex() (int, error) {
+	if partySlot < 1 || partySlot > 6 {
+		return 0, fmt.Errorf("slot must be between 1 and 6")
+	}
+	return partySlot - 1, nil
+}
+
+func runPartySetLevel(cmd *cobra.Command, args []string) error {
+	slot, err := partySlotIndex()
+	if err != nil {
+		return err
+	}
+	if partyLevel < 1 || partyLevel > party.MaxLevel {
+		return fmt.Errorf("level must be between 1 and %d", party.MaxLevel)
+	}
+
+	return runSaveEdit(saveEdit{
+		savePath: args[0],
+		output:   partyOutput,
+		dryRun:   partyDryRun,
+		force:    partyForce,
+		plan: func(tx *edit.Transaction) error {
+			s := tx.Save
+			mon, err := party.GetPartyMon(s, slot)
+			if err != nil {
+				return err
+			}
+			name := species.GetName(mon.Species)
+			fmt.Printf("  Party slot %d (%s):\n", slot+1, name)
+			fmt.Printf("    - Level: %d → %d\n", mon.Level, partyLevel)
+			tx.Add(fmt.Sprintf("Set %s (slot %d) to level %d", name, slot+1, partyLevel), func(s *save.Save) error {
+				if err := party.SetLevel(s, slot, partyLevel); err != nil {
+					return fmt.Errorf("failed to set level: %w", err)
+				}
+				return nil
+			})
+			return nil
+		},
+	})
+}
+
+func runPartySetMoves(cmd *cobra.Command, args []string) error {
+	slot, err := partySlotIndex()
+	if err != nil {
+		return err
+	}
+	if len(partyMoves) == 0 || len(partyMoves) > 4 {
+		return fmt.Errorf("between 1 and 4 --move flags must be specified")
+	}
+
+	var newMoves [4]byte
+	for i, name := range partyMoves {
+		id, err := moves.GetMoveID(name)
+		if err != nil {
+			return fmt.Errorf("invalid move %d: %w", i+1, err)
+		}
+		newMoves[i] = id
+	}
+
+	return runSaveEdit(saveEdit{
+		savePath: args[0],
+		output:   partyOutput,
+		dryRun:   partyDryRun,
+		force:    partyForce,
+		plan: func(tx *edit.Transaction) error {
+			s := tx.Save
+			mon, err := party.GetPartyMon(s, slot)
+			if err != nil {
+				return err
+			}
+			name := species.GetName(mon.Species)
+			fmt.Printf("  Party slot %d (%s):\n", slot+1, name)
+			fmt.Printf("    - Moves: %s → %s\n", formatMoves(mon.Moves), formatMoves(newMoves))
+			tx.Add(fmt.Sprintf("Set moves of %s (slot %d) to %s", name, slot+1, formatMoves(newMoves)), func(s *save.Save) error {
+				if err := party.SetMoves(s, slot, newMoves); err != nil {
+					return fmt.Errorf("failed to set moves: %w", err)
+				}
+				return nil
+			})
+			return nil
+		},
+	})
+}
+
+func runPartySetDVs(cmd *cobra.Command, args []string) error {
+	slot, err := partySlotIndex()
+	if err != nil {
+		return err
+	}
+	for _, dv := range []int{partyDVAtk, partyDVDef, partyDVSpd, partyDVSpc} {
+		if dv < -1 || dv > party.MaxDV {
+			return fmt.Errorf("DVs must be between 0 and %d", party.MaxDV)
+		}
+	}
+
+	// mergeDVs overlays the DVs given on the command line onto the current ones
+	mergeDVs := func(current party.DVs) party.DVs {
+		pick := func(flag int, cur byte) byte {
+			if flag < 0 {
+				return cur
+			}
+			return byte(flag)
+		}
+		return party.DVs{
+			Attack:  pick(partyDVAtk, current.Attack),
+			Defense: pick(partyDVDef, current.Defense),
+			Speed:   pick(partyDVSpd, current.Speed),
+			Special: pick(partyDVSpc, current.Special),
+		}
+	}
+
+	return runSaveEdit(saveEdit{
+		savePath: args[0],
+		output:   partyOutput,
+		dryRun:   partyDryRun,
+		force:    partyForce,
+		plan: func(tx *edit.Transaction) error {
+			s := tx.Save
+			mon, err := party.GetPartyMon(s, slot)
+			if err != nil {
+				return err
+			}
+			dvs := mergeDVs(mon.DVs)
+			name := species.GetName(mon.Species)
+			fmt.Printf("  Party slot %d (%s):\n", slot+1, name)
+			fmt.Printf("    - DVs: %s → %s\n", formatDVs(mon.DVs), formatDVs(dvs))
+			tx.Add(fmt.Sprintf("Set DVs of %s (slot %d) to %s", name, slot+1, formatDVs(dvs)), func(s *save.Save) error {
+				mon, err := party.GetPartyMon(s, slot)
+				if err != nil {
+					return err
+				}
+				if err := party.SetDVs(s, slot, mergeDVs(mon.DVs)); err != nil {
+					return fmt.Errorf("failed to set DVs: %w", err)
+				}
+				return nil
+			})
+			return nil
+		},
+	})
+}
+
+func formatDVs(d party.DVs) string {
+	return fmt.Sprintf("%d/%d/%d/%d/%d", d.HP(), d.Attack, d.Defense, d.Speed, d.Special)
+}
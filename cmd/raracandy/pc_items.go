@@ -0,0 +1,260 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/abravonunez/raracandy/internal/edit"
+	"github.com/abravonunez/raracandy/internal/gen1/items"
+	"github.com/abravonunez/raracandy/internal/gen1/save"
+	"github.com/spf13/cobra"
+)
+
+var (
+	pcItemsOutput string
+	pcItemsDryRun bool
+	pcItemsForce  bool
+	pcItemsName   string
+	pcItemsQty    int
+)
+
+var pcItemsCmd = &cobra.Command{
+	Use:   "pc-items",
+	Short: "Inspect and edit the PC item storage",
+	Long: `Inspect and edit the items stored in the PC (up to 50 different items).
+
+Examples:
+  raracandy pc-items list pokemon.sav
+  raracandy pc-items add pokemon.sav --item rare_candy --qty 99 --out modified.sav
+  raracandy pc-items remove pokemon.sav --item potion --out modified.sav
+  raracandy pc-items move-to-bag pokemon.sav --item rare_candy --qty 10 --out modified.sav`,
+}
+
+var pcItemsListCmd = &cobra.Command{
+	Use:   "list <save-file>",
+	Short: "List the items stored in the PC",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runPCItemsList,
+}
+
+var pcItemsAddCmd = &cobra.Command{
+	Use:   "add <save-file>",
+	Short: "Add or modify an item in the PC",
+	Long: `Add an item to the PC storage or update its quantity if it already exists.
+
+The save file will not be modified unless --out is specified.
+Use --dry-run to preview changes without writing.`,
+	Args: cobra.ExactArgs(1),
+	RunE: runPCItemsAdd,
+}
+
+var pcItemsRemoveCmd = &cobra.Command{
+	Use:   "remove <save-file>",
+	Short: "Remove an item from the PC",
+	Long: `Remove an item from the PC storage. Without --qty the whole stack is removed.
+
+The save file will not be modified unless --out is specified.
+Use --dry-run to preview changes without writing.`,
+	Args: cobra.ExactArgs(1),
+	RunE: runPCItemsRemove,
+}
+
+var pcItemsMoveCmd = &cobra.Command{
+	Use:   "move-to-bag <save-file>",
+	Short: "Withdraw an item from the PC into the bag",
+	Long: `Move an item from the PC storage to the bag, merging with an existing stack.
+Without --qty the whole stack is moved.
+
+The save file will not be modified unless --out is specified.
+Use --dry-run to preview changes without writing.`,
+	Args: cobra.ExactArgs(1),
+	RunE: runPCItemsMoveToBag,
+}
+
+func init() {
+	rootCmd.AddCommand(pcItemsCmd)
+	pcItemsCmd.AddCommand(pcItemsListCmd, pcItemsAddCmd, pcItemsRemoveCmd, pcItemsMoveCmd)
+
+	for _, cmd := range []*cobra.Command{pcItemsAddCmd, pcItemsRemoveCmd, pcItemsMoveCmd} {
+		cmd.Flags().StringVarP(&pcItemsOutput, "out", "o", "", "Output file path (required)")
+		cmd.Flags().BoolVar(&pcItemsDryRun, "dry-run", false, "Preview changes without writing")
+		cmd.Flags().BoolVar(&pcItemsForce, "force", false, "Skip confirmation prompt")
+		cmd.Flags().StringVar(&pcItemsName, "item", "", "Item name (e.g., rare_candy)")
+		cmd.MarkFlagRequired("out")
+		cmd.MarkFlagRequired("item")
+	}
+
+	pcItemsAddCmd.Flags().IntVar(&pcItemsQty, "qty", 99, "Item quantity (1-99)")
+	pcItemsRemoveCmd.Flags().IntVar(&pcItemsQty, "qty", 0, "Quantity to remove (default: all)")
+	pcItemsMoveCmd.Flags().IntVar(&pcItemsQty, "qty", 0, "Quantity to move (default: all)")
+}
+
+func runPCItemsList(cmd *cobra.Command, args []string) error {
+	savePath := args[0]
+
+	s, err := loadSave(savePath)
+	if err != nil {
+		return fmt.Errorf("failed to load save: %w", err)
+	}
+
+	pcItems := items.GetItems(s, items.PCBox(s))
+	fmt.Printf("PC Items (%d/%d items):\n", len(pcItems), s.GetProfile().MaxPCItems)
+	if len(pcItems) == 0 {
+		fmt.Println("  (empty)")
+	} else {
+		for _, item := range pcItems {
+			fmt.Printf("  - %s x%d\n", item.Name, item.Quantity)
+		}
+	}
+
+	return nil
+}
+
+// pcItemQuantity returns the quantity of an item in a list, or 0 if absent
+func pcItemQuantity(s *save.Save, list items.ItemList, itemID byte) (byte, bool) {
+	idx := items.FindIndex(s, list, itemID)
+	if idx < 0 {
+		return 0, false
+	}
+	return items.GetItems(s, list)[idx].Quantity, true
+}
+
+// resolvePCQuantity applies the "--qty 0 means the whole stack" rule
+func resolvePCQuantity(s *save.Save, itemID byte) (byte, byte, error) {
+	current, ok := pcItemQuantity(s, items.PCBox(s), itemID)
+	if !ok {
+		return 0, 0, fmt.Errorf("%s is not stored in the PC", items.GetItemName(itemID))
+	}
+	qty := byte(pcItemsQty)
+	if pcItemsQty == 0 {
+		qty = current
+	}
+	if qty > current {
+		return 0, 0, fmt.Errorf("only %d %s stored in the PC", current, items.GetItemName(itemID))
+	}
+	return current, qty, nil
+}
+
+func runPCItemsAdd(cmd *cobra.Command, args []string) error {
+	if pcItemsQty < 1 || pcItemsQty > items.MaxItemQty {
+		return fmt.Errorf("quantity must be between 1 and %d", items.MaxItemQty)
+	}
+	itemID, err := items.GetItemID(pcItemsName)
+	if err != nil {
+		return fmt.Errorf("invalid item: %w", err)
+	}
+	if err := checkItemQuantity(itemID, pcItemsQty); err != nil {
+		return err
+	}
+	itemName := items.GetItemName(itemID)
+
+	return runSaveEdit(saveEdit{
+		savePath: args[0],
+		output:   pcItemsOutput,
+		dryRun:   pcItemsDryRun,
+		force:    pcItemsForce,
+		plan: func(tx *edit.Transaction) error {
+			s := tx.Save
+			fmt.Println("  PC items:")
+			if current, ok := pcItemQuantity(s, items.PCBox(s), itemID); ok {
+				fmt.Printf("    - %s: %d → %d (%+d)\n", itemName, current, pcItemsQty, pcItemsQty-int(current))
+			} else {
+				fmt.Printf("    - %s: (new) → %d\n", itemName, pcItemsQty)
+			}
+			tx.Add(fmt.Sprintf("Store %s in the PC with quantity %d", itemName, pcItemsQty), func(s *save.Save) error {
+				if err := items.SetQuantity(s, items.PCBox(s), itemID, byte(pcItemsQty)); err != nil {
+					return fmt.Errorf("failed to set item: %w", err)
+				}
+				return nil
+			})
+			return nil
+		},
+	})
+}
+
+func runPCItemsRemove(cmd *cobra.Command, args []string) error {
+	if pcItemsQty < 0 || pcItemsQty > items.MaxItemQty {
+		return fmt.Errorf("quantity must be between 0 and %d (0 removes the whole stack)", items.MaxItemQty)
+	}
+	itemID, err := items.GetItemID(pcItemsName)
+	if err != nil {
+		return fmt.Errorf("invalid item: %w", err)
+	}
+	itemName := items.GetItemName(itemID)
+
+	var qty byte
+	return runSaveEdit(saveEdit{
+		savePath: args[0],
+		output:   pcItemsOutput,
+		dryRun:   pcItemsDryRun,
+		force:    pcItemsForce,
+		plan: func(tx *edit.Transaction) error {
+			s := tx.Save
+			current, n, err := resolvePCQuantity(s, itemID)
+			if err != nil {
+				return err
+			}
+			qty = n
+			fmt.Println("  PC items:")
+			if qty == current {
+				fmt.Printf("    - %s: %d → (removed)\n", itemName, current)
+			} else {
+				fmt.Printf("    - %s: %d → %d (-%d)\n", itemName, current, current-qty, qty)
+			}
+			tx.Add(fmt.Sprintf("Remove %d %s from the PC", qty, itemName), func(s *save.Save) error {
+				if err := items.Toss(s, items.PCBox(s), itemID, qty); err != nil {
+					return fmt.Errorf("failed to remove item: %w", err)
+				}
+				return nil
+			})
+			return nil
+		},
+	})
+}
+
+func runPCItemsMoveToBag(cmd *cobra.Command, args []string) error {
+	if pcItemsQty < 0 || pcItemsQty > items.MaxItemQty {
+		return fmt.Errorf("quantity must be between 0 and %d (0 moves the whole stack)", items.MaxItemQty)
+	}
+	itemID, err := items.GetItemID(pcItemsName)
+	if err != nil {
+		return fmt.Errorf("invalid item: %w", err)
+	}
+	itemName := items.GetItemName(itemID)
+
+	var qty byte
+	return runSaveEdit(saveEdit{
+		savePath: args[0],
+		output:   pcItemsOutput,
+		dryRun:   pcItemsDryRun,
+		force:    pcItemsForce,
+		plan: func(tx *edit.Transaction) error {
+			s := tx.Save
+			current, n, err := resolvePCQuantity(s, itemID)
+			if err != nil {
+				return err
+			}
+			qty = n
+			bag := items.Bag(s)
+			inBag, stacked := pcItemQuantity(s, bag, itemID)
+			if int(inBag)+int(qty) > items.MaxItemQty {
+				return fmt.Errorf("bag would hold %d %s, maximum is %d", int(inBag)+int(qty), itemName, items.MaxItemQty)
+			}
+			// A new stack needs a free slot; check it now rather than after
+			// the user has confirmed
+			if !stacked && len(items.GetItems(s, bag)) >= bag.Capacity {
+				return fmt.Errorf("%s is full (max %d items)", bag.Name, bag.Capacity)
+			}
+			fmt.Println("  PC items:")
+			fmt.Printf("    - %s: %d → %d (-%d)\n", itemName, current, current-qty, qty)
+			fmt.Println("  Bag items:")
+			fmt.Printf("    - %s: %d → %d (+%d)\n", itemName, inBag, inBag+qty, qty)
+			tx.Add(fmt.Sprintf("Move %d %s from the PC to the bag", qty, itemName), func(s *save.Save) error {
+				if err := items.Move(s, items.PCBox(s), items.Bag(s), itemID, qty); err != nil {
+					return fmt.Errorf("failed to move item: %w", err)
+				}
+				return nil
+			})
+			return nil
+		},
+	})
+}
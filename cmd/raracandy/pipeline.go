@@ -0,0 +1,36 @@
+package main
+
+import (
+	"github.com/abravonunez/raracandy/internal/edit"
+)
+
+// saveEdit describes a mutating command. It runs through edit.Run, which
+// handles the integrity check → preview → confirm → apply → backup → write
+// → verify pipeline shared by every mutating command.
+type saveEdit struct {
+	savePath string
+	output   string
+	dryRun   bool
+	force    bool
+
+	// plan prints the planned changes and registers them with tx.Add
+	plan func(tx *edit.Transaction) error
+}
+
+// runSaveEdit executes a saveEdit with the global --region and --game
+// overrides
+func runSaveEdit(e saveEdit) error {
+	opts, err := loadOptions()
+	if err != nil {
+		return err
+	}
+	return edit.Run(edit.Edit{
+		Path:    e.savePath,
+		Output:  e.output,
+		DryRun:  e.dryRun,
+		Force:   e.force,
+		Load:    opts,
+		Command: commandPath,
+		Plan:    e.plan,
+	})
+}
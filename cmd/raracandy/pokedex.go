@@ -0,0 +1,198 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/abravonunez/raracandy/internal/edit"
+	"github.com/abravonunez/raracandy/internal/gen1/pokedex"
+	"github.com/abravonunez/raracandy/internal/gen1/save"
+	"github.com/abravonunez/raracandy/internal/gen1/species"
+	"github.com/spf13/cobra"
+)
+
+var (
+	pokedexOutput  string
+	pokedexDryRun  bool
+	pokedexForce   bool
+	pokedexSpecies []string
+	pokedexAll     bool
+)
+
+var pokedexCmd = &cobra.Command{
+	Use:   "pokedex",
+	Short: "Inspect and edit the Pokédex seen/owned flags",
+	Long: `Inspect and edit the Pokédex. Species can be given by name or by
+National Dex number. Marking a Pokémon as owned also marks it as seen.
+
+Examples:
+  raracandy pokedex show pokemon.sav
+  raracandy pokedex mark-seen pokemon.sav --species mew,150 --out modified.sav
+  raracandy pokedex mark-owned pokemon.sav --species pikachu --out modified.sav
+  raracandy pokedex complete pokemon.sav --out modified.sav`,
+}
+
+var pokedexShowCmd = &cobra.Command{
+	Use:   "show <save-file>",
+	Short: "List the Pokémon seen and owned",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runPokedexShow,
+}
+
+var pokedexMarkSeenCmd = &cobra.Command{
+	Use:   "mark-seen <save-file>",
+	Short: "Mark Pokémon as seen",
+	Long: `Mark one or more Pokémon as seen.
+
+The save file will not be modified unless --out is specified.
+Use --dry-run to preview changes without writing.`,
+	Args: cobra.ExactArgs(1),
+	RunE: runPokedexMarkSeen,
+}
+
+var pokedexMarkOwnedCmd = &cobra.Command{
+	Use:   "mark-owned <save-file>",
+	Short: "Mark Pokémon as owned (and seen)",
+	Long: `Mark one or more Pokémon as owned. Owned Pokémon are also marked as seen.
+
+The save file will not be modified unless --out is specified.
+Use --dry-run to preview changes without writing.`,
+	Args: cobra.ExactArgs(1),
+	RunE: runPokedexMarkOwned,
+}
+
+var pokedexCompleteCmd = &cobra.Command{
+	Use:   "complete <save-file>",
+	Short: "Mark all 151 Pokémon as seen and owned",
+	Long: `Mark all 151 Pokémon as seen and owned.
+
+The save file will not be modified unless --out is specified.
+Use --dry-run to preview changes without writing.`,
+	Args: cobra.ExactArgs(1),
+	RunE: runPokedexComplete,
+}
+
+func init() {
+	rootCmd.AddCommand(pokedexCmd)
+	pokedexCmd.AddCommand(pokedexShowCmd, pokedexMarkSeenCmd, pokedexMarkOwnedCmd, pokedexCompleteCmd)
+
+	pokedexShowCmd.Flags().BoolVar(&pokedexAll, "all", false, "List all 151 entries, including unseen ones")
+
+	for _, cmd := range []*cobra.Command{pokedexMarkSeenCmd, pokedexMarkOwnedCmd, pokedexCompleteCmd} {
+		cmd.Flags().StringVarP(&pokedexOutput, "out", "o", "", "Output file path (required)")
+		cmd.Flags().BoolVar(&pokedexDryRun, "dry-run", false, "Preview changes without writing")
+		cmd.Flags().BoolVar(&pokedexForce, "force", false, "Skip confirmation prompt")
+		cmd.MarkFlagRequired("out")
+	}
+	for _, cmd := range []*cobra.Command{pokedexMarkSeenCmd, pokedexMarkOwnedCmd} {
+		cmd.Flags().StringSliceVar(&pokedexSpecies, "species", nil, "Species names or dex numbers, comma-separated (e.g., pikachu,150)")
+		cmd.MarkFlagRequired("species")
+	}
+}
+
+func runPokedexShow(cmd *cobra.Command, args []string) error {
+	s, err := loadSave(args[0])
+	if err != nil {
+		return fmt.Errorf("failed to load save: %w", err)
+	}
+
+	owned, seen := pokedex.GetOwned(s), pokedex.GetSeen(s)
+	fmt.Printf("Pokédex: %d owned, %d seen (of %d)\n", owned.Count(), seen.Count(), species.MaxDex)
+
+	for _, n := range pokedex.All() {
+		status := "-"
+		switch {
+		case owned.Has(n):
+			status = "owned"
+		case seen.Has(n):
+			status = "seen"
+		case !pokedexAll:
+			continue
+		}
+		fmt.Printf("  #%03d %-12s %s\n", n, dexName(n), status)
+	}
+
+	return nil
+}
+
+func runPokedexMarkSeen(cmd *cobra.Command, args []string) error {
+	return runPokedexMark(args[0], false)
+}
+
+func runPokedexMarkOwned(cmd *cobra.Command, args []string) error {
+	return runPokedexMark(args[0], true)
+}
+
+// runPokedexMark marks the --species list as seen, or as owned and seen
+func runPokedexMark(savePath string, owned bool) error {
+	dex := make([]int, 0, len(pokedexSpecies))
+	for _, arg := range pokedexSpecies {
+		n, err := pokedex.Lookup(arg)
+		if err != nil {
+			return err
+		}
+		dex = append(dex, n)
+	}
+
+	flag := "seen"
+	if owned {
+		flag = "owned"
+	}
+
+	return runSaveEdit(saveEdit{
+		savePath: savePath,
+		output:   pokedexOutput,
+		dryRun:   pokedexDryRun,
+		force:    pokedexForce,
+		plan: func(tx *edit.Transaction) error {
+			s := tx.Save
+			current := pokedex.GetSeen(s)
+			if owned {
+				current = pokedex.GetOwned(s)
+			}
+			names := make([]string, 0, len(dex))
+			fmt.Println("  Pokédex:")
+			for _, n := range dex {
+				names = append(names, dexName(n))
+				if current.Has(n) {
+					fmt.Printf("    - #%03d %s: already %s\n", n, dexName(n), flag)
+				} else {
+					fmt.Printf("    - #%03d %s: mark %s\n", n, dexName(n), flag)
+				}
+			}
+			tx.Add(fmt.Sprintf("Mark %s as %s", strings.Join(names, ", "), flag), func(s *save.Save) error {
+				if owned {
+					return pokedex.MarkOwned(s, dex...)
+				}
+				return pokedex.MarkSeen(s, dex...)
+			})
+			return nil
+		},
+	})
+}
+
+func runPokedexComplete(cmd *cobra.Command, args []string) error {
+	return runSaveEdit(saveEdit{
+		savePath: args[0],
+		output:   pokedexOutput,
+		dryRun:   pokedexDryRun,
+		force:    pokedexForce,
+		plan: func(tx *edit.Transaction) error {
+			s := tx.Save
+			fmt.Println("  Pokédex:")
+			fmt.Printf("    - Owned: %d → %d\n", pokedex.GetOwned(s).Count(), species.MaxDex)
+			fmt.Printf("    - Seen: %d → %d\n", pokedex.GetSeen(s).Count(), species.MaxDex)
+			tx.Add(fmt.Sprintf("Mark all %d Pokémon as seen and owned", species.MaxDex), pokedex.Complete)
+			return nil
+		},
+	})
+}
+
+// dexName returns the species name for a National Dex number
+func dexName(n int) string {
+	index, err := species.IndexFromDex(n)
+	if err != nil {
+		return "???"
+	}
+	return species.GetName(index)
+}
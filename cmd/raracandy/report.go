@@ -0,0 +1,203 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/abravonunez/raracandy/internal/gen1/items"
+	"github.com/abravonunez/raracandy/internal/gen1/money"
+	"github.com/abravonunez/raracandy/internal/gen1/options"
+	"github.com/abravonunez/raracandy/internal/gen1/pikachu"
+	"github.com/abravonunez/raracandy/internal/gen1/save"
+	"github.com/abravonunez/raracandy/internal/gen1/trainer"
+	"github.com/spf13/cobra"
+)
+
+// Exit codes, documented in the README so CI pipelines can gate on them
+const (
+	exitOK           = 0
+	exitFailure      = 1 // usage error, unreadable file, failed write
+	exitInvalidSave  = 2 // the save failed validation or the integrity check
+	exitHashMismatch = 3 // verify --expected-hash or a restore backup did not match
+)
+
+// exitError carries a specific process exit code up to main
+type exitError struct {
+	code int
+	err  error
+}
+
+func (e *exitError) Error() string {
+	return e.err.Error()
+}
+
+func (e *exitError) Unwrap() error {
+	return e.err
+}
+
+// exitCode maps an error returned by a command to the process exit code
+func exitCode(err error) int {
+	if err == nil {
+		return exitOK
+	}
+	var ee *exitError
+	if errors.As(err, &ee) {
+		return ee.code
+	}
+	var ve *save.ValidationError
+	if errors.As(err, &ve) {
+		return exitInvalidSave
+	}
+	return exitFailure
+}
+
+var outputFormat string
+
+// addOutputFlag registers --output on a read-only command
+func addOutputFlag(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&outputFormat, "output", "text", "Output format: text, json or yaml")
+}
+
+// saveReport is the machine-readable schema shared by inspect and verify.
+// Bump reportSchemaVersion when fields are renamed or removed.
+type saveReport struct {
+	SchemaVersion int              `json:"schema_version"`
+	File          string           `json:"file"`
+	SHA256        string           `json:"sha256"`
+	HashMatches   *bool            `json:"hash_matches,omitempty"`
+	Version       versionReport    `json:"version"`
+	Checksums     []checksumReport `json:"checksums"`
+	Trainer       trainerReport    `json:"trainer"`
+	Money         uint32           `json:"money"`
+	Coins         uint32           `json:"coins"`
+	Options       optionsReport    `json:"options"`
+	Bag           []itemReport     `json:"bag"`
+	PCItems       []itemReport     `json:"pc_items"`
+	Integrity     integrityReport  `json:"integrity"`
+}
+
+const reportSchemaVersion = 1
+
+type versionReport struct {
+	Name       string   `json:"name"`
+	Game       string   `json:"game"`
+	Region     string   `json:"region"`
+	Confidence float64  `json:"confidence"`
+	Evidence   []string `json:"evidence"`
+}
+
+type checksumReport struct {
+	Name       string `json:"name"`
+	Stored     string `json:"stored"`
+	Calculated string `json:"calculated"`
+	Valid      bool   `json:"valid"`
+}
+
+type trainerReport struct {
+	Player   string   `json:"player"`
+	Rival    string   `json:"rival"`
+	ID       uint16   `json:"id"`
+	Badges   []string `json:"badges"`
+	PlayTime string   `json:"play_time"`
+
+	// Pikachu is only reported for Yellow saves
+	Pikachu *pikachuReport `json:"pikachu,omitempty"`
+}
+
+type pikachuReport struct {
+	Friendship byte   `json:"friendship"`
+	Mood       string `json:"mood"`
+}
+
+type optionsReport struct {
+	TextSpeed   string `json:"text_speed"`
+	Animations  bool   `json:"animations"`
+	BattleStyle string `json:"battle_style"`
+	Sound       string `json:"sound"`
+}
+
+type itemReport struct {
+	ID       byte   `json:"id"`
+	Name     string `json:"name"`
+	Quantity byte   `json:"quantity"`
+}
+
+type integrityReport struct {
+	Valid    bool     `json:"valid"`
+	Errors   []string `json:"errors"`
+	Warnings []string `json:"warnings"`
+}
+
+// buildSaveReport collects the machine-readable view of a loaded save
+func buildSaveReport(path string, s *save.Save, report save.IntegrityReport) saveReport {
+	r := saveReport{
+		SchemaVersion: reportSchemaVersion,
+		File:          path,
+		SHA256:        s.GetSHA256(),
+		Version: versionReport{
+			Name:       report.GameVersion.String(),
+			Game:       report.GameVersion.Game().String(),
+			Region:     report.Region.String(),
+			Confidence: report.Confidence,
+			Evidence:   report.Evidence,
+		},
+		Checksums: make([]checksumReport, 0, len(report.Checksums)),
+		Trainer: trainerReport{
+			Player:   trainer.GetPlayerName(s),
+			Rival:    trainer.GetRivalName(s),
+			ID:       trainer.GetID(s),
+			Badges:   badgeReports(trainer.GetBadges(s)),
+			PlayTime: trainer.GetPlayTime(s).String(),
+		},
+		Money:   money.GetMoney(s),
+		Coins:   money.GetCoins(s),
+		Options: optionsReports(options.Get(s)),
+		Bag:     itemReports(items.GetBagItems(s)),
+		PCItems: itemReports(items.GetItems(s, items.PCBox(s))),
+		Integrity: integrityReport{
+			Valid:    report.IsValid,
+			Errors:   report.Errors,
+			Warnings: report.Warnings,
+		},
+	}
+
+	if friendship, err := pikachu.GetFriendship(s); err == nil {
+		r.Trainer.Pikachu = &pikachuReport{Friendship: friendship, Mood: pikachu.MoodFor(friendship).String()}
+	}
+
+	for _, c := range report.Checksums {
+		r.Checksums = append(r.Checksums, checksumReport{
+			Name:       c.Name,
+			Stored:     fmt.Sprintf("0x%02X", c.Stored),
+			Calculated: fmt.Sprintf("0x%02X", c.Calculated),
+			Valid:      c.Valid(),
+		})
+	}
+
+	return r
+}
+
+func itemReports(list []items.Item) []itemReport {
+	reports := make([]itemReport, 0, len(list))
+	for _, item := range list {
+		reports = append(reports, itemReport{ID: item.ID, Name: item.Name, Quantity: item.Quantity})
+	}
+	return reports
+}
+
+func optionsReports(o options.Options) optionsReport {
+	return optionsReport{
+		TextSpeed:   o.TextSpeed.String(),
+		Animations:  o.Animations,
+		BattleStyle: o.BattleStyle.String(),
+		Sound:       o.Sound.String(),
+	}
+}
+
+func badgeReports(badges trainer.Badges) []string {
+	names := make([]string, 0, trainer.BadgeCount)
+	for _, badge := range badges.List() {
+		names = append(names, badge.String())
+	}
+	return names
+}
@@ -0,0 +1,155 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/abravonunez/raracandy/internal/backup"
+	"github.com/abravonunez/raracandy/internal/gen1/profile"
+	"github.com/abravonunez/raracandy/internal/gen1/save"
+	"github.com/spf13/cobra"
+)
+
+var (
+	restoreFrom   string
+	restoreDryRun bool
+	restoreForce  bool
+)
+
+var restoreCmd = &cobra.Command{
+	Use:   "restore <save-file>",
+	Short: "Roll a save back to a verified backup",
+	Long: `Replace a save with one of its backups.
+
+The backups of the save (<save>.bak, <save>.bak.<time> and the snapshots
+in .raracandy/backups) are listed and each one is checked against its
+.sha256 hash file or the snapshot manifest. Backups that do not match, or
+have no recorded hash, are refused. Without --from the newest verified
+backup is used.
+
+The chosen backup must also pass the integrity check. The current save is
+first stored as a snapshot, then replaced atomically.
+
+Examples:
+  raracandy restore pokemon.sav
+  raracandy restore pokemon.sav --from pokemon.sav.bak --dry-run`,
+	Args: cobra.ExactArgs(1),
+	RunE: runRestore,
+}
+
+func init() {
+	rootCmd.AddCommand(restoreCmd)
+
+	restoreCmd.Flags().StringVar(&restoreFrom, "from", "", "Backup file to restore (default: newest verified backup)")
+	restoreCmd.Flags().BoolVar(&restoreDryRun, "dry-run", false, "Check the backup without restoring it")
+	restoreCmd.Flags().BoolVar(&restoreForce, "force", false, "Skip confirmation prompt")
+}
+
+func runRestore(cmd *cobra.Command, args []string) error {
+	target := args[0]
+	cmd.SilenceUsage = true
+
+	backups, err := backup.List(target)
+	if err != nil {
+		return fmt.Errorf("failed to list backups: %w", err)
+	}
+
+	fmt.Printf("Backups of %s:\n", target)
+	if len(backups) == 0 {
+		fmt.Println("  (none)")
+	}
+	for _, b := range backups {
+		status := "✓ verified"
+		if !b.Verified() {
+			status = "✗ " + b.Err.Error()
+		}
+		fmt.Printf("  %s  %s  %s\n", b.ModTime.Format("2006-01-02 15:04:05"), b.Path, status)
+	}
+	fmt.Println()
+
+	chosen, err := chooseBackup(backups)
+	if err != nil {
+		return err
+	}
+
+	// The backup must be a save we would accept to edit
+	fmt.Printf("🔍 Checking %s...\n", chosen.Path)
+	candidate, err := loadSave(chosen.Path)
+	if err != nil {
+		return fmt.Errorf("cannot restore %s: %w", chosen.Path, err)
+	}
+	report := candidate.CheckIntegrity()
+	if !report.IsValid {
+		fmt.Println("\n❌ Backup integrity check failed:")
+		for _, e := range report.Errors {
+			fmt.Printf("  • %s\n", e)
+		}
+		return &exitError{exitInvalidSave, fmt.Errorf("refusing to restore a backup that fails the integrity check")}
+	}
+	fmt.Printf("✓ Integrity check passed\n")
+	fmt.Printf("✓ Detected: %s\n", report.GameVersion)
+	fmt.Printf("✓ SHA256: %s\n", chosen.Hash)
+	fmt.Println()
+
+	if restoreDryRun {
+		fmt.Println("[DRY RUN] No changes written")
+		return nil
+	}
+
+	if !restoreForce {
+		if !save.ConfirmWithDetails([]string{fmt.Sprintf("Replace %s with %s", target, chosen.Path)}) {
+			fmt.Println("\n❌ Operation cancelled by user")
+			return nil
+		}
+	}
+
+	// Keep the save being replaced, so the restore can be undone
+	if _, err := os.Stat(target); err == nil {
+		var version fmt.Stringer = profile.VersionUnknown
+		if current, err := loadSave(target); err == nil {
+			version = current.CheckIntegrity().GameVersion
+		}
+		fmt.Println("💾 Creating backup...")
+		if err := snapshotSave(target, version, []string{fmt.Sprintf("Restore from %s", chosen.Path)}); err != nil {
+			return err
+		}
+	}
+
+	if err := backup.Restore(chosen.Path, target); err != nil {
+		return fmt.Errorf("failed to restore: %w", err)
+	}
+
+	restored, err := loadSave(target)
+	if err != nil {
+		return fmt.Errorf("failed to verify restored file: %w", err)
+	}
+	if restored.GetSHA256() != chosen.Hash {
+		return fmt.Errorf("verification failed: restored file does not match the backup")
+	}
+
+	fmt.Printf("✓ Restored %s from %s\n", target, chosen.Path)
+	fmt.Printf("✓ Verification passed\n")
+	return nil
+}
+
+// chooseBackup picks --from, or the newest verified backup. Backups that
+// fail verification are refused.
+func chooseBackup(backups []backup.Backup) (backup.Backup, error) {
+	if restoreFrom == "" {
+		for _, b := range backups {
+			if b.Verified() {
+				return b, nil
+			}
+		}
+		return backup.Backup{}, fmt.Errorf("no verified backup to restore")
+	}
+
+	chosen, err := backup.Inspect(restoreFrom)
+	if err != nil {
+		return backup.Backup{}, err
+	}
+	if !chosen.Verified() {
+		return backup.Backup{}, &exitError{exitHashMismatch, fmt.Errorf("refusing to restore %s: %w", chosen.Path, chosen.Err)}
+	}
+	return chosen, nil
+}
@@ -4,9 +4,19 @@ import (
 	"fmt"
 	"os"
 
+	"github.com/abravonunez/raracandy/internal/gen1/profile"
+	"github.com/abravonunez/raracandy/internal/gen1/save"
 	"github.com/spf13/cobra"
 )
 
+var (
+	regionFlag string
+	gameFlag   string
+)
+
+// commandPath is the command being run, recorded with backup snapshots
+var commandPath string
+
 var rootCmd = &cobra.Command{
 	Use:   "raracandy",
 	Short: "A CLI tool to safely edit Pokémon Gen 1 save files",
@@ -22,11 +32,43 @@ Or with explicit version (backward compatibility):
   raracandy yellow add-item pokemon.sav --item rare_candy --qty 99 --out modified.sav
 
 Never distributes or modifies ROMs - only operates on save files you own.`,
+	PersistentPreRun: func(cmd *cobra.Command, args []string) {
+		commandPath = cmd.CommandPath()
+	},
+}
+
+func init() {
+	rootCmd.PersistentFlags().StringVar(&regionFlag, "region", "auto",
+		"Force the cartridge region when auto-detection is ambiguous (auto, na, jp, fr, de, it, es)")
+	rootCmd.PersistentFlags().StringVar(&gameFlag, "game", "auto",
+		"Force the game when auto-detection is ambiguous (auto, yellow, redblue)")
+}
+
+// loadSave loads a save file, applying the global --region and --game overrides
+func loadSave(path string) (*save.Save, error) {
+	opts, err := loadOptions()
+	if err != nil {
+		return nil, err
+	}
+	return save.LoadWith(path, opts)
+}
+
+// loadOptions returns the load options set by --region and --game
+func loadOptions() (save.LoadOptions, error) {
+	region, err := profile.ParseRegion(regionFlag)
+	if err != nil {
+		return save.LoadOptions{}, err
+	}
+	game, err := profile.ParseGame(gameFlag)
+	if err != nil {
+		return save.LoadOptions{}, err
+	}
+	return save.LoadOptions{Region: region, Game: game}, nil
 }
 
 func main() {
 	if err := rootCmd.Execute(); err != nil {
 		fmt.Fprintln(os.Stderr, err)
-		os.Exit(1)
+		os.Exit(exitCode(err))
 	}
 }
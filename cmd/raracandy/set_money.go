@@ -3,7 +3,7 @@ package main
 import (
 	"fmt"
 
-	"github.com/abravonunez/raracandy/internal/backup"
+	"github.com/abravonunez/raracandy/internal/edit"
 	"github.com/abravonunez/raracandy/internal/gen1/money"
 	"github.com/abravonunez/raracandy/internal/gen1/save"
 	"github.com/spf13/cobra"
@@ -48,95 +48,25 @@ func runSetMoney(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("amount must be between 0 and %d", money.MaxMoney)
 	}
 
-	// Load save file
-	fmt.Println("⚙️  Loading save...")
-	s, err := save.Load(savePath)
-	if err != nil {
-		return fmt.Errorf("failed to load save: %w", err)
-	}
-
-	// Perform integrity check
-	fmt.Println("🔍 Running integrity check...")
-	report := s.CheckIntegrity()
-
-	if !report.IsValid {
-		fmt.Println("\n❌ Save file integrity check failed:")
-		for _, err := range report.Errors {
-			fmt.Printf("  • %s\n", err)
-		}
-		return fmt.Errorf("cannot modify corrupted save file")
-	}
-
-	fmt.Printf("✓ Integrity check passed\n")
-	fmt.Printf("✓ Detected: %s\n", report.GameVersion)
-	fmt.Println()
-
-	// Get current money
-	currentMoney := money.GetMoney(s)
-
-	// Preview changes
-	fmt.Println("Changes to be applied:")
-	fmt.Printf("  Money: %s → %s (%+d)\n",
-		money.FormatMoney(currentMoney),
-		money.FormatMoney(uint32(setMoneyAmount)),
-		setMoneyAmount-int(currentMoney))
-
-	oldChecksum := s.GetChecksum()
-	fmt.Printf("  Checksum: 0x%02X → (will recalculate)\n", oldChecksum)
-
-	if setMoneyDryRun {
-		fmt.Println("\n[DRY RUN] No changes written")
-		return nil
-	}
-
-	// Ask for confirmation if not in force mode
-	if !setMoneyForce {
-		changes := []string{
-			fmt.Sprintf("Set money to %s", money.FormatMoney(uint32(setMoneyAmount))),
-			"Recalculate checksum",
-		}
-		if !save.ConfirmWithDetails(changes) {
-			fmt.Println("\n❌ Operation cancelled by user")
+	amount := uint32(setMoneyAmount)
+	return runSaveEdit(saveEdit{
+		savePath: savePath,
+		output:   setMoneyOutput,
+		dryRun:   setMoneyDryRun,
+		force:    setMoneyForce,
+		plan: func(tx *edit.Transaction) error {
+			currentMoney := money.GetMoney(tx.Save)
+			fmt.Printf("  Money: %s → %s (%+d)\n",
+				money.FormatMoney(currentMoney),
+				money.FormatMoney(amount),
+				setMoneyAmount-int(currentMoney))
+			tx.Add(fmt.Sprintf("Set money to %s", money.FormatMoney(amount)), func(s *save.Save) error {
+				if err := money.SetMoney(s, amount); err != nil {
+					return fmt.Errorf("failed to set money: %w", err)
+				}
+				return nil
+			})
 			return nil
-		}
-	}
-
-	// Apply changes
-	fmt.Println("\n✍️  Applying changes...")
-	if err := money.SetMoney(s, uint32(setMoneyAmount)); err != nil {
-		return fmt.Errorf("failed to set money: %w", err)
-	}
-
-	// Get original hash before backup
-	originalHash := s.GetSHA256()
-
-	// Create backup with hash
-	fmt.Println("💾 Creating backup...")
-	if err := backup.CreateBackupWithHash(savePath, originalHash); err != nil {
-		return fmt.Errorf("failed to create backup: %w", err)
-	}
-	fmt.Printf("✓ Backup created: %s\n", backup.GetBackupPath(savePath))
-	fmt.Printf("✓ Backup hash saved: %s.bak.sha256\n", savePath)
-
-	// Write output
-	if err := s.Write(setMoneyOutput); err != nil {
-		return fmt.Errorf("failed to write save: %w", err)
-	}
-
-	// Verify written file
-	written, err := save.Load(setMoneyOutput)
-	if err != nil {
-		return fmt.Errorf("failed to verify written file: %w", err)
-	}
-	if !written.ValidateChecksum() {
-		return fmt.Errorf("verification failed: checksum invalid after write")
-	}
-
-	newChecksum := s.GetChecksum()
-	fmt.Printf("\n✓ Save written: %s\n", setMoneyOutput)
-	fmt.Printf("✓ Checksum updated: 0x%02X → 0x%02X\n", oldChecksum, newChecksum)
-	fmt.Printf("✓ Verification passed\n")
-	fmt.Printf("\n🎉 Success! Your save is ready to use.")
-
-	return nil
+		},
+	})
 }
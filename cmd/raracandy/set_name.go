@@ -0,0 +1,93 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/abravonunez/raracandy/internal/edit"
+	"github.com/abravonunez/raracandy/internal/gen1/save"
+	"github.com/abravonunez/raracandy/internal/gen1/text"
+	"github.com/abravonunez/raracandy/internal/gen1/trainer"
+	"github.com/spf13/cobra"
+)
+
+var (
+	setNameOutput string
+	setNameDryRun bool
+	setNameForce  bool
+	setNamePlayer string
+	setNameRival  string
+)
+
+var setNameCmd = &cobra.Command{
+	Use:   "set-name <save-file>",
+	Short: "Rename the player or the rival",
+	Long: `Rename the player and/or the rival.
+Names follow the in-game naming screen: at most 7 characters (5 on Japanese
+saves), letters and the symbols × ( ) : ; [ ] - ? ! ♂ ♀ / . , only.
+European saves also accept the accented letters of their naming screen.
+Use <PK> and <MN> for the PK and MN glyphs.
+
+The save file will not be modified unless --out is specified.
+Use --dry-run to preview changes without writing.
+
+Examples:
+  raracandy set-name pokemon.sav --player ASH --out modified.sav
+  raracandy set-name pokemon.sav --player RED --rival BLUE --out modified.sav`,
+	Args: cobra.ExactArgs(1),
+	RunE: runSetName,
+}
+
+func init() {
+	rootCmd.AddCommand(setNameCmd)
+
+	setNameCmd.Flags().StringVarP(&setNameOutput, "out", "o", "", "Output file path (required)")
+	setNameCmd.Flags().BoolVar(&setNameDryRun, "dry-run", false, "Preview changes without writing")
+	setNameCmd.Flags().BoolVar(&setNameForce, "force", false, "Skip confirmation prompt")
+	setNameCmd.Flags().StringVar(&setNamePlayer, "player", "", "New player name")
+	setNameCmd.Flags().StringVar(&setNameRival, "rival", "", "New rival name")
+
+	setNameCmd.MarkFlagRequired("out")
+}
+
+func runSetName(cmd *cobra.Command, args []string) error {
+	if setNamePlayer == "" && setNameRival == "" {
+		return fmt.Errorf("at least one of --player or --rival must be specified")
+	}
+
+	return runSaveEdit(saveEdit{
+		savePath: args[0],
+		output:   setNameOutput,
+		dryRun:   setNameDryRun,
+		force:    setNameForce,
+		plan: func(tx *edit.Transaction) error {
+			s := tx.Save
+			charset := s.GetProfile().Charset
+			fmt.Println("  Trainer:")
+			if setNamePlayer != "" {
+				if _, err := text.EncodeName(setNamePlayer, charset, text.PlayerNameLength(charset)); err != nil {
+					return fmt.Errorf("invalid player name: %w", err)
+				}
+				fmt.Printf("    - Player: %s → %s\n", trainer.GetPlayerName(s), setNamePlayer)
+				tx.Add(fmt.Sprintf("Rename player to %s", setNamePlayer), func(s *save.Save) error {
+					if err := trainer.SetPlayerName(s, setNamePlayer); err != nil {
+						return fmt.Errorf("failed to set player name: %w", err)
+					}
+					return nil
+				})
+			}
+			if setNameRival != "" {
+				if _, err := text.EncodeName(setNameRival, charset, text.PlayerNameLength(charset)); err != nil {
+					return fmt.Errorf("invalid rival name: %w", err)
+				}
+				fmt.Printf("    - Rival: %s → %s\n", trainer.GetRivalName(s), setNameRival)
+				tx.Add(fmt.Sprintf("Rename rival to %s", setNameRival), func(s *save.Save) error {
+					if err := trainer.SetRivalName(s, setNameRival); err != nil {
+						return fmt.Errorf("failed to set rival name: %w", err)
+					}
+					return nil
+				})
+			}
+			return nil
+		},
+	})
+}
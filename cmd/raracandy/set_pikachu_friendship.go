@@ -0,0 +1,80 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/abravonunez/raracandy/internal/edit"
+	"github.com/abravonunez/raracandy/internal/gen1/pikachu"
+	"github.com/abravonunez/raracandy/internal/gen1/save"
+	"github.com/spf13/cobra"
+)
+
+var (
+	setFriendshipOutput string
+	setFriendshipDryRun bool
+	setFriendshipForce  bool
+	setFriendshipValue  int
+)
+
+var setPikachuFriendshipCmd = &cobra.Command{
+	Use:   "set-pikachu-friendship <save-file>",
+	Short: "Set the starter Pikachu's friendship (Yellow only)",
+	Long: `Set the friendship of the Pikachu that follows the player in Pokémon Yellow.
+The value ranges from 0 to 255 and decides Pikachu's mood:
+
+  0-50     dislikes you
+  51-100   neutral
+  101-130  likes you
+  131-160  happy
+  161-200  very happy
+  201-255  loves you
+
+Red/Blue saves are refused. Use --game yellow if detection got it wrong.
+
+The save file will not be modified unless --out is specified.
+Use --dry-run to preview changes without writing.
+
+Examples:
+  raracandy set-pikachu-friendship pokemon.sav --value 255 --out modified.sav`,
+	Args: cobra.ExactArgs(1),
+	RunE: runSetPikachuFriendship,
+}
+
+func init() {
+	rootCmd.AddCommand(setPikachuFriendshipCmd)
+
+	setPikachuFriendshipCmd.Flags().StringVarP(&setFriendshipOutput, "out", "o", "", "Output file path (required)")
+	setPikachuFriendshipCmd.Flags().BoolVar(&setFriendshipDryRun, "dry-run", false, "Preview changes without writing")
+	setPikachuFriendshipCmd.Flags().BoolVar(&setFriendshipForce, "force", false, "Skip confirmation prompt")
+	setPikachuFriendshipCmd.Flags().IntVar(&setFriendshipValue, "value", 0, "Friendship (0-255)")
+
+	setPikachuFriendshipCmd.MarkFlagRequired("out")
+	setPikachuFriendshipCmd.MarkFlagRequired("value")
+}
+
+func runSetPikachuFriendship(cmd *cobra.Command, args []string) error {
+	if setFriendshipValue < 0 || setFriendshipValue > pikachu.MaxFriendship {
+		return fmt.Errorf("friendship must be between 0 and %d", pikachu.MaxFriendship)
+	}
+	friendship := byte(setFriendshipValue)
+
+	return runSaveEdit(saveEdit{
+		savePath: args[0],
+		output:   setFriendshipOutput,
+		dryRun:   setFriendshipDryRun,
+		force:    setFriendshipForce,
+		plan: func(tx *edit.Transaction) error {
+			s := tx.Save
+			current, err := pikachu.GetFriendship(s)
+			if err != nil {
+				return err
+			}
+			fmt.Printf("  Pikachu friendship: %d (%s) → %d (%s)\n",
+				current, pikachu.MoodFor(current), friendship, pikachu.MoodFor(friendship))
+			tx.Add(fmt.Sprintf("Set Pikachu's friendship to %d", friendship), func(s *save.Save) error {
+				return pikachu.SetFriendship(s, friendship)
+			})
+			return nil
+		},
+	})
+}
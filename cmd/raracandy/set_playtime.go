@@ -0,0 +1,84 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/abravonunez/raracandy/internal/edit"
+	"github.com/abravonunez/raracandy/internal/gen1/save"
+	"github.com/abravonunez/raracandy/internal/gen1/trainer"
+	"github.com/spf13/cobra"
+)
+
+var (
+	setPlayTimeOutput string
+	setPlayTimeDryRun bool
+	setPlayTimeForce  bool
+	setPlayTimeValue  string
+	setPlayTimeMax    bool
+)
+
+var setPlayTimeCmd = &cobra.Command{
+	Use:   "set-playtime <save-file>",
+	Short: "Set the play time shown on the trainer card",
+	Long: `Set the play time shown on the trainer card, as h:mm or h:mm:ss.
+Minutes and seconds must be under 60. The in-game clock stops at 255:59
+and sets a "maxed" flag; --max (or --time 255:59) sets exactly that, and
+other times with 255 hours are refused.
+
+The save file will not be modified unless --out is specified.
+Use --dry-run to preview changes without writing.
+
+Examples:
+  raracandy set-playtime pokemon.sav --time 12:34 --out modified.sav
+  raracandy set-playtime pokemon.sav --max --out modified.sav`,
+	Args: cobra.ExactArgs(1),
+	RunE: runSetPlayTime,
+}
+
+func init() {
+	rootCmd.AddCommand(setPlayTimeCmd)
+
+	setPlayTimeCmd.Flags().StringVarP(&setPlayTimeOutput, "out", "o", "", "Output file path (required)")
+	setPlayTimeCmd.Flags().BoolVar(&setPlayTimeDryRun, "dry-run", false, "Preview changes without writing")
+	setPlayTimeCmd.Flags().BoolVar(&setPlayTimeForce, "force", false, "Skip confirmation prompt")
+	setPlayTimeCmd.Flags().StringVar(&setPlayTimeValue, "time", "", "Play time as h:mm or h:mm:ss (0:00-254:59:59, or 255:59 for the capped clock)")
+	setPlayTimeCmd.Flags().BoolVar(&setPlayTimeMax, "max", false, "Set the capped 255:59 play time")
+
+	setPlayTimeCmd.MarkFlagRequired("out")
+}
+
+func runSetPlayTime(cmd *cobra.Command, args []string) error {
+	if (setPlayTimeValue == "") == !setPlayTimeMax {
+		return fmt.Errorf("exactly one of --time or --max must be specified")
+	}
+
+	playTime := trainer.MaxPlayTime()
+	if !setPlayTimeMax {
+		var err error
+		if playTime, err = trainer.ParsePlayTime(setPlayTimeValue); err != nil {
+			return err
+		}
+	}
+
+	return runSaveEdit(saveEdit{
+		savePath: args[0],
+		output:   setPlayTimeOutput,
+		dryRun:   setPlayTimeDryRun,
+		force:    setPlayTimeForce,
+		plan: func(tx *edit.Transaction) error {
+			s := tx.Save
+			fmt.Printf("  Play time: %s → %s", trainer.GetPlayTime(s), playTime)
+			if playTime.Maxed {
+				fmt.Print(" (maxed)")
+			}
+			fmt.Println()
+			tx.Add(fmt.Sprintf("Set play time to %s", playTime), func(s *save.Save) error {
+				if err := trainer.SetPlayTime(s, playTime); err != nil {
+					return fmt.Errorf("failed to set play time: %w", err)
+				}
+				return nil
+			})
+			return nil
+		},
+	})
+}
@@ -2,9 +2,11 @@ package main
 
 import (
 	"fmt"
+	"os"
 
 	"github.com/abravonunez/raracandy/internal/gen1/profile"
 	"github.com/abravonunez/raracandy/internal/gen1/save"
+	"github.com/abravonunez/raracandy/internal/output"
 	"github.com/spf13/cobra"
 )
 
@@ -18,11 +20,15 @@ var verifyCmd = &cobra.Command{
 	Long: `Performs comprehensive integrity checks on a save file without modifying it.
 Checks include:
 - File size validation
-- Checksum verification
+- Checksum verification (main data and PC box banks)
 - Game version detection
 - Bag structure validation
 - Money format validation
-- SHA256 hash (optional)`,
+- SHA256 hash (optional)
+
+Use --output json or --output yaml for machine-readable output.
+
+Exit codes: 0 valid, 1 usage or I/O error, 2 invalid save, 3 hash mismatch.`,
 	Args: cobra.ExactArgs(1),
 	RunE: runVerify,
 }
@@ -31,17 +37,29 @@ func init() {
 	yellowCmd.AddCommand(verifyCmd)
 
 	verifyCmd.Flags().StringVar(&verifyExpectedHash, "expected-hash", "", "Expected SHA256 hash to verify against")
+	addOutputFlag(verifyCmd)
 }
 
 func runVerify(cmd *cobra.Command, args []string) error {
 	savePath := args[0]
 
+	format, err := output.ParseFormat(outputFormat)
+	if err != nil {
+		return err
+	}
+	// Arguments are valid from here on; failures should not print usage
+	cmd.SilenceUsage = true
+
 	// Load save file
-	s, err := save.Load(savePath)
+	s, err := loadSave(savePath)
 	if err != nil {
 		return fmt.Errorf("failed to load save: %w", err)
 	}
 
+	if format != output.FormatText {
+		return runVerifyMachine(savePath, s, format)
+	}
+
 	fmt.Printf("Save File: %s\n", savePath)
 	fmt.Printf("Size: %d KB\n", len(s.Data())/1024)
 	fmt.Println()
@@ -51,6 +69,11 @@ func runVerify(cmd *cobra.Command, args []string) error {
 
 	// Game version
 	fmt.Printf("Detected Version: %s\n", report.GameVersion)
+	fmt.Printf("Region: %s (%s text)\n", report.Region, s.GetProfile().Charset)
+	fmt.Printf("Game Confidence: %.0f%%\n", report.Confidence*100)
+	for _, e := range report.Evidence {
+		fmt.Printf("  • %s\n", e)
+	}
 	if report.GameVersion == profile.VersionUnknown {
 		fmt.Println("  ⚠️  Warning: Unknown version - offsets may be incorrect")
 	}
@@ -67,6 +90,21 @@ func runVerify(cmd *cobra.Command, args []string) error {
 	}
 	fmt.Println()
 
+	// Box bank checksums
+	fmt.Println("PC Box Checksums:")
+	if len(report.Checksums) > 1 {
+		for _, c := range report.Checksums[1:] {
+			status := "✓"
+			if !c.Valid() {
+				status = "✗"
+			}
+			fmt.Printf("  %-20s stored 0x%02X, calculated 0x%02X %s\n", c.Name+":", c.Stored, c.Calculated, status)
+		}
+	} else {
+		fmt.Println("  Status:     - Boxes not initialized yet (no box checksums)")
+	}
+	fmt.Println()
+
 	// Bag validation
 	fmt.Println("Bag Structure:")
 	if report.BagValid {
@@ -85,6 +123,24 @@ func runVerify(cmd *cobra.Command, args []string) error {
 	}
 	fmt.Println()
 
+	// Coin validation
+	fmt.Println("Coins Format:")
+	if report.CoinsValid {
+		fmt.Println("  Status:     ✓ Valid BCD encoding")
+	} else {
+		fmt.Println("  Status:     ✗ Invalid BCD encoding")
+	}
+	fmt.Println()
+
+	// Options validation
+	fmt.Println("Options Byte:")
+	if report.OptionsValid {
+		fmt.Println("  Status:     ✓ Valid")
+	} else {
+		fmt.Println("  Status:     ✗ Invalid bit combination (see warnings)")
+	}
+	fmt.Println()
+
 	// SHA256 hash
 	hash := s.GetSHA256()
 	fmt.Printf("SHA256: %s\n", hash)
@@ -94,7 +150,7 @@ func runVerify(cmd *cobra.Command, args []string) error {
 			fmt.Println("  Status:     ✓ Hash matches expected value")
 		} else {
 			fmt.Println("  Status:     ✗ Hash does NOT match")
-			return fmt.Errorf("hash mismatch")
+			return &exitError{exitHashMismatch, fmt.Errorf("hash mismatch")}
 		}
 	}
 	fmt.Println()
@@ -123,6 +179,31 @@ func runVerify(cmd *cobra.Command, args []string) error {
 		return nil
 	} else {
 		fmt.Println("Overall Status: ✗ INVALID - Do NOT modify this save!")
-		return fmt.Errorf("integrity check failed")
+		return &exitError{exitInvalidSave, fmt.Errorf("integrity check failed")}
+	}
+}
+
+// runVerifyMachine prints the verify results as JSON or YAML. The exit code
+// is the same as in text mode.
+func runVerifyMachine(savePath string, s *save.Save, format output.Format) error {
+	report := s.CheckIntegrity()
+	doc := buildSaveReport(savePath, s, report)
+
+	hashMatches := true
+	if verifyExpectedHash != "" {
+		hashMatches = s.ValidateAgainstHash(verifyExpectedHash)
+		doc.HashMatches = &hashMatches
+	}
+
+	if err := output.Write(os.Stdout, format, doc); err != nil {
+		return err
+	}
+
+	if !hashMatches {
+		return &exitError{exitHashMismatch, fmt.Errorf("hash mismatch")}
+	}
+	if !report.IsValid {
+		return &exitError{exitInvalidSave, fmt.Errorf("integrity check failed")}
 	}
+	return nil
 }
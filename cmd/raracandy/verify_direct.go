@@ -10,11 +10,15 @@ Automatically detects whether the save file is from Pokémon Red, Blue, or Yello
 
 Checks include:
 - File size validation
-- Checksum verification
+- Checksum verification (main data and PC box banks)
 - Game version detection
 - Bag structure validation
 - Money format validation
-- SHA256 hash (optional)`,
+- SHA256 hash (optional)
+
+Use --output json or --output yaml for machine-readable output.
+
+Exit codes: 0 valid, 1 usage or I/O error, 2 invalid save, 3 hash mismatch.`,
 	Args: cobra.ExactArgs(1),
 	RunE: runVerify, // Reuse the same logic from verify.go
 }
@@ -23,4 +27,5 @@ func init() {
 	rootCmd.AddCommand(verifyDirectCmd)
 
 	verifyDirectCmd.Flags().StringVar(&verifyExpectedHash, "expected-hash", "", "Expected SHA256 hash to verify against")
+	addOutputFlag(verifyDirectCmd)
 }
@@ -0,0 +1,88 @@
+// Package atomicfile writes files so that a crash or a failed write never
+// leaves a truncated file behind: readers see either the old contents or
+// the complete new ones.
+package atomicfile
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+)
+
+// file is the part of *os.File used while writing the temporary file
+type file interface {
+	io.Writer
+	Name() string
+	Chmod(mode os.FileMode) error
+	Sync() error
+	Close() error
+}
+
+// Hooks replaced by tests to inject failures
+var (
+	createTemp = func(dir, pattern string) (file, error) { return os.CreateTemp(dir, pattern) }
+	rename     = os.Rename
+	syncDir    = syncDirectory
+)
+
+// WriteFile writes data to a temporary file next to path, syncs it to disk
+// and renames it over path, then syncs the directory so the rename itself
+// is durable. An existing file keeps its mode; a new one is created with
+// perm.
+func WriteFile(path string, data []byte, perm os.FileMode) error {
+	mode := perm
+	if info, err := os.Stat(path); err == nil {
+		mode = info.Mode().Perm()
+	}
+
+	dir := filepath.Dir(path)
+	tmp, err := createTemp(dir, "."+filepath.Base(path)+".tmp-*")
+	if err != nil {
+		return fmt.Errorf("failed to create temporary file: %w", err)
+	}
+	committed := false
+	defer func() {
+		if !committed {
+			tmp.Close()
+			os.Remove(tmp.Name())
+		}
+	}()
+
+	if _, err := tmp.Write(data); err != nil {
+		return fmt.Errorf("failed to write temporary file: %w", err)
+	}
+	if err := tmp.Chmod(mode); err != nil {
+		return fmt.Errorf("failed to set file mode: %w", err)
+	}
+	if err := tmp.Sync(); err != nil {
+		return fmt.Errorf("failed to sync temporary file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to close temporary file: %w", err)
+	}
+	if err := rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("failed to replace %s: %w", path, err)
+	}
+	committed = true
+
+	if err := syncDir(dir); err != nil {
+		return fmt.Errorf("failed to sync directory %s: %w", dir, err)
+	}
+	return nil
+}
+
+// syncDirectory flushes a directory entry change (such as a rename) to disk.
+// Windows cannot sync directories; its renames are flushed with the file.
+func syncDirectory(dir string) error {
+	if runtime.GOOS == "windows" {
+		return nil
+	}
+	d, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	defer d.Close()
+	return d.Sync()
+}
@@ -0,0 +1,148 @@
+package atomicfile
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+var errInjected = errors.New("injected failure")
+
+// faultyFile fails at one step of WriteFile. A failing write still writes
+// half of the data, like a disk filling up mid-write.
+type faultyFile struct {
+	*os.File
+	failAt string
+}
+
+func (f *faultyFile) Write(p []byte) (int, error) {
+	if f.failAt == "write" {
+		n, _ := f.File.Write(p[:len(p)/2])
+		return n, errInjected
+	}
+	return f.File.Write(p)
+}
+
+func (f *faultyFile) Chmod(mode os.FileMode) error {
+	if f.failAt == "chmod" {
+		return errInjected
+	}
+	return f.File.Chmod(mode)
+}
+
+func (f *faultyFile) Sync() error {
+	if f.failAt == "sync" {
+		return errInjected
+	}
+	return f.File.Sync()
+}
+
+func (f *faultyFile) Close() error {
+	if f.failAt == "close" {
+		f.File.Close()
+		return errInjected
+	}
+	return f.File.Close()
+}
+
+// inject makes the next WriteFile fail at step, restoring the real
+// functions when the test ends
+func inject(t *testing.T, step string) {
+	origCreate, origRename, origSyncDir := createTemp, rename, syncDir
+	t.Cleanup(func() { createTemp, rename, syncDir = origCreate, origRename, origSyncDir })
+
+	switch step {
+	case "create":
+		createTemp = func(dir, pattern string) (file, error) { return nil, errInjected }
+	case "rename":
+		rename = func(oldpath, newpath string) error { return errInjected }
+	case "syncdir":
+		syncDir = func(dir string) error { return errInjected }
+	default:
+		createTemp = func(dir, pattern string) (file, error) {
+			f, err := os.CreateTemp(dir, pattern)
+			if err != nil {
+				return nil, err
+			}
+			return &faultyFile{File: f, failAt: step}, nil
+		}
+	}
+}
+
+func TestWriteFile(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "pokemon.sav")
+
+	if err := WriteFile(path, []byte("new file"), 0640); err != nil {
+		t.Fatal(err)
+	}
+	if info, _ := os.Stat(path); info.Mode().Perm() != 0640 {
+		t.Errorf("new file mode = %v, want 0640", info.Mode().Perm())
+	}
+
+	os.Chmod(path, 0600)
+	if err := WriteFile(path, []byte("replaced"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	data, _ := os.ReadFile(path)
+	if string(data) != "replaced" {
+		t.Errorf("data = %q, want %q", data, "replaced")
+	}
+	if info, _ := os.Stat(path); info.Mode().Perm() != 0600 {
+		t.Errorf("replaced file mode = %v, want the original 0600", info.Mode().Perm())
+	}
+	assertNoTempFiles(t, dir)
+}
+
+func TestWriteFileFailures(t *testing.T) {
+	old := []byte("old save data")
+	updated := []byte("complete new save data")
+
+	for _, step := range []string{"create", "write", "chmod", "sync", "close", "rename"} {
+		t.Run(step, func(t *testing.T) {
+			dir := t.TempDir()
+			path := filepath.Join(dir, "pokemon.sav")
+			os.WriteFile(path, old, 0600)
+
+			inject(t, step)
+			if err := WriteFile(path, updated, 0644); !errors.Is(err, errInjected) {
+				t.Fatalf("WriteFile() error = %v, want the injected failure", err)
+			}
+
+			data, _ := os.ReadFile(path)
+			if string(data) != string(old) {
+				t.Errorf("file = %q after a failed %s, want the old contents", data, step)
+			}
+			assertNoTempFiles(t, dir)
+		})
+	}
+
+	// Once the rename happened the new contents are in place, but the
+	// caller still learns that they may not be durable yet
+	t.Run("syncdir", func(t *testing.T) {
+		dir := t.TempDir()
+		path := filepath.Join(dir, "pokemon.sav")
+		os.WriteFile(path, old, 0600)
+
+		inject(t, "syncdir")
+		if err := WriteFile(path, updated, 0644); !errors.Is(err, errInjected) {
+			t.Fatalf("WriteFile() error = %v, want the injected failure", err)
+		}
+		data, _ := os.ReadFile(path)
+		if string(data) != string(updated) {
+			t.Errorf("file = %q, want the complete new contents", data)
+		}
+		assertNoTempFiles(t, dir)
+	})
+}
+
+func assertNoTempFiles(t *testing.T, dir string) {
+	t.Helper()
+	entries, _ := os.ReadDir(dir)
+	for _, e := range entries {
+		if e.Name() != "pokemon.sav" {
+			t.Errorf("leftover file %s", e.Name())
+		}
+	}
+}
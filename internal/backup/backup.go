@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"os"
 	"time"
+
+	"github.com/abravonunez/raracandy/internal/atomicfile"
 )
 
 // CreateBackup creates a backup of the specified file
@@ -28,9 +30,13 @@ func createBackupWithPath(srcPath, dstPath string) error {
 	if err != nil {
 		return fmt.Errorf("failed to read source file: %w", err)
 	}
+	info, err := os.Stat(srcPath)
+	if err != nil {
+		return fmt.Errorf("failed to read source file: %w", err)
+	}
 
-	// Write backup file
-	if err := os.WriteFile(dstPath, data, 0644); err != nil {
+	// Write backup file atomically, with the source's mode
+	if err := atomicfile.WriteFile(dstPath, data, info.Mode().Perm()); err != nil {
 		return fmt.Errorf("failed to write backup file: %w", err)
 	}
 
@@ -3,38 +3,49 @@ package backup
 import (
 	"fmt"
 	"os"
+
+	"github.com/abravonunez/raracandy/internal/atomicfile"
 )
 
-// CreateBackupWithHash creates a backup and saves its SHA256 hash
-func CreateBackupWithHash(path string, hash string) error {
+// CreateBackupWithHash writes data, the contents of path before an edit,
+// to the .bak backup and saves its SHA256 hash. The backup is read back
+// and checked against the saved hash. It returns the hash.
+func CreateBackupWithHash(path string, data []byte) (string, error) {
+	backupPath := GetBackupPath(path)
+	mode := os.FileMode(0644)
+	if info, err := os.Stat(path); err == nil {
+		mode = info.Mode().Perm()
+	}
+
 	// Create the backup
-	if err := CreateBackup(path); err != nil {
-		return err
+	if err := atomicfile.WriteFile(backupPath, data, mode); err != nil {
+		return "", fmt.Errorf("failed to write backup file: %w", err)
 	}
 
 	// Save the hash
-	hashPath := path + ".bak.sha256"
-	hashContent := fmt.Sprintf("%s  %s.bak\n", hash, path)
+	hash := hashBytes(data)
+	hashContent := fmt.Sprintf("%s  %s\n", hash, backupPath)
+	if err := atomicfile.WriteFile(SidecarPath(backupPath), []byte(hashContent), 0644); err != nil {
+		return "", fmt.Errorf("failed to write hash file: %w", err)
+	}
 
-	if err := os.WriteFile(hashPath, []byte(hashContent), 0644); err != nil {
-		return fmt.Errorf("failed to write hash file: %w", err)
+	// Check what actually landed on disk
+	written, err := Inspect(backupPath)
+	if err != nil {
+		return "", err
+	}
+	if written.Hash != hash || !written.Verified() {
+		return "", fmt.Errorf("backup verification failed: %s does not match the saved hash", backupPath)
 	}
 
-	return nil
+	return hash, nil
 }
 
 // VerifyBackupHash checks if a backup matches its saved hash
 func VerifyBackupHash(path string, currentHash string) (bool, error) {
-	hashPath := path + ".bak.sha256"
-
-	data, err := os.ReadFile(hashPath)
+	savedHash, err := readSidecar(GetBackupPath(path))
 	if err != nil {
-		return false, fmt.Errorf("failed to read hash file: %w", err)
+		return false, err
 	}
-
-	// Parse hash from file (format: "hash  filename")
-	var savedHash string
-	fmt.Sscanf(string(data), "%s", &savedHash)
-
 	return savedHash == currentHash, nil
 }
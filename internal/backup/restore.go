@@ -0,0 +1,155 @@
+package backup
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/abravonunez/raracandy/internal/atomicfile"
+)
+
+// Backup is a backup file of a save, with the result of checking it
+// against its .sha256 sidecar or, for snapshots in the backup store,
+// against the store's manifest
+type Backup struct {
+	Path     string
+	ModTime  time.Time
+	Hash     string // SHA-256 of the backup file
+	Expected string // hash recorded in the sidecar or manifest, empty when missing
+	Err      error  // why the backup could not be verified
+}
+
+// Verified reports whether the backup matches its recorded hash
+func (b Backup) Verified() bool {
+	return b.Err == nil
+}
+
+// SidecarPath returns the path of the hash file kept next to a backup
+func SidecarPath(backupPath string) string {
+	return backupPath + ".sha256"
+}
+
+// FileHash returns the SHA-256 of a file's contents
+func FileHash(path string) (string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	return hashBytes(data), nil
+}
+
+func hashBytes(data []byte) string {
+	return fmt.Sprintf("%x", sha256.Sum256(data))
+}
+
+// readSidecar returns the hash stored in a sidecar ("hash  filename")
+func readSidecar(backupPath string) (string, error) {
+	data, err := os.ReadFile(SidecarPath(backupPath))
+	if err != nil {
+		return "", fmt.Errorf("failed to read hash file: %w", err)
+	}
+
+	var savedHash string
+	fmt.Sscanf(string(data), "%s", &savedHash)
+	return savedHash, nil
+}
+
+// expectedHash returns the hash recorded for a backup: its sidecar, or the
+// manifest entry of a snapshot in the backup store
+func expectedHash(backupPath string) (string, error) {
+	hash, err := readSidecar(backupPath)
+	if err == nil {
+		return hash, nil
+	}
+	if hash, merr := manifestHash(backupPath); merr == nil {
+		return hash, nil
+	}
+	return "", err
+}
+
+// Inspect hashes a backup file and checks it against its recorded hash
+func Inspect(backupPath string) (Backup, error) {
+	info, err := os.Stat(backupPath)
+	if err != nil {
+		return Backup{}, fmt.Errorf("failed to read backup: %w", err)
+	}
+
+	b := Backup{Path: backupPath, ModTime: info.ModTime()}
+	if b.Hash, err = FileHash(backupPath); err != nil {
+		return Backup{}, fmt.Errorf("failed to read backup: %w", err)
+	}
+
+	if b.Expected, err = expectedHash(backupPath); err != nil {
+		b.Err = fmt.Errorf("no hash file (%s) to verify it against", filepath.Base(SidecarPath(backupPath)))
+	} else if b.Expected != b.Hash {
+		b.Err = fmt.Errorf("SHA-256 does not match %s - the backup was modified", filepath.Base(SidecarPath(backupPath)))
+	}
+	return b, nil
+}
+
+// List returns the backups of a save (<path>.bak, the timestamped
+// <path>.bak.<time> copies and its snapshots in the backup store), newest
+// first
+func List(path string) ([]Backup, error) {
+	matches, err := filepath.Glob(globEscape(GetBackupPath(path)) + "*")
+	if err != nil {
+		return nil, err
+	}
+
+	backups := make([]Backup, 0, len(matches))
+	for _, match := range matches {
+		if strings.HasSuffix(match, ".sha256") {
+			continue
+		}
+		b, err := Inspect(match)
+		if err != nil {
+			return nil, err
+		}
+		backups = append(backups, b)
+	}
+
+	m, err := LoadManifest(path)
+	if err != nil {
+		return nil, err
+	}
+	for _, e := range m.For(path) {
+		b := Backup{Path: e.Path(path), ModTime: e.Created, Expected: e.SHA256}
+		b.Hash, _ = FileHash(b.Path)
+		b.Err = e.Verify(path)
+		backups = append(backups, b)
+	}
+
+	sort.SliceStable(backups, func(i, j int) bool {
+		return backups[i].ModTime.After(backups[j].ModTime)
+	})
+	return backups, nil
+}
+
+// Restore replaces target with a verified backup. The backup is checked
+// against its recorded hash again right before copying, and the target is
+// replaced atomically: it holds either the old or the restored bytes.
+func Restore(backupPath, target string) error {
+	data, err := os.ReadFile(backupPath)
+	if err != nil {
+		return fmt.Errorf("failed to read backup: %w", err)
+	}
+	expected, err := expectedHash(backupPath)
+	if err != nil {
+		return err
+	}
+	if hashBytes(data) != expected {
+		return fmt.Errorf("backup %s does not match its recorded hash", backupPath)
+	}
+
+	return atomicfile.WriteFile(target, data, 0644)
+}
+
+// globEscape escapes the glob metacharacters of a literal path
+func globEscape(path string) string {
+	replacer := strings.NewReplacer(`\`, `\\`, `*`, `\*`, `?`, `\?`, `[`, `\[`)
+	return replacer.Replace(path)
+}
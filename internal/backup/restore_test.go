@@ -0,0 +1,90 @@
+package backup
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRestore(t *testing.T) {
+	dir := t.TempDir()
+	target := filepath.Join(dir, "pokemon.sav")
+	if err := os.WriteFile(target, []byte("original"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	entry, err := Snapshot(target, SnapshotInfo{Command: "raracandy set-coins"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	backupPath := entry.Path(target)
+	if err := os.WriteFile(target, []byte("edited"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	backups, err := List(target)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(backups) != 1 || backups[0].Path != backupPath || !backups[0].Verified() {
+		t.Fatalf("List() = %+v, want one verified %s", backups, backupPath)
+	}
+
+	if err := Restore(backupPath, target); err != nil {
+		t.Fatal(err)
+	}
+	data, _ := os.ReadFile(target)
+	if string(data) != "original" {
+		t.Errorf("restored data = %q, want %q", data, "original")
+	}
+	if info, _ := os.Stat(target); info.Mode().Perm() != 0600 {
+		t.Errorf("restored mode = %v, want 0600", info.Mode().Perm())
+	}
+}
+
+func TestRestoreRefusesUnverified(t *testing.T) {
+	dir := t.TempDir()
+	target := filepath.Join(dir, "pokemon.sav")
+	os.WriteFile(target, []byte("original"), 0644)
+
+	if _, err := CreateBackupWithHash(target, []byte("original")); err != nil {
+		t.Fatal(err)
+	}
+	tampered := GetBackupPath(target)
+	os.WriteFile(tampered, []byte("tampered"), 0644)
+
+	b, err := Inspect(tampered)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if b.Verified() || !strings.Contains(b.Err.Error(), "modified") {
+		t.Errorf("Inspect(tampered).Err = %v, want a hash mismatch", b.Err)
+	}
+	if err := Restore(tampered, target); err == nil {
+		t.Error("Restore() should refuse a tampered backup")
+	}
+
+	missing := target + ".bak.20250101-120000"
+	os.WriteFile(missing, []byte("original"), 0644)
+	if b, _ := Inspect(missing); b.Verified() {
+		t.Error("a backup without a hash file should not be verified")
+	}
+	if err := Restore(missing, target); err == nil {
+		t.Error("Restore() should refuse a backup without a hash file")
+	}
+
+	entry, err := Snapshot(target, SnapshotInfo{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	os.WriteFile(entry.Path(target), []byte("tampered"), 0644)
+	if err := Restore(entry.Path(target), target); err == nil {
+		t.Error("Restore() should refuse a snapshot that does not match the manifest")
+	}
+
+	data, _ := os.ReadFile(target)
+	if string(data) != "original" {
+		t.Errorf("target was modified: %q", data)
+	}
+}
@@ -0,0 +1,262 @@
+package backup
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/abravonunez/raracandy/internal/atomicfile"
+)
+
+// StoreDirName is the backup store kept next to the edited saves
+const StoreDirName = ".raracandy/backups"
+
+// ManifestName is the manifest file inside the store
+const ManifestName = "manifest.json"
+
+// manifestSchemaVersion is bumped when manifest fields are renamed or removed
+const manifestSchemaVersion = 1
+
+// Entry describes one snapshot in the backup store
+type Entry struct {
+	File        string    `json:"file"`   // snapshot file name inside the store
+	Source      string    `json:"source"` // file name of the save it was taken from
+	Created     time.Time `json:"created"`
+	SHA256      string    `json:"sha256"`
+	GameVersion string    `json:"game_version"`
+	Command     string    `json:"command"` // command that was about to modify the save
+	Changes     []string  `json:"changes"`
+}
+
+// SnapshotInfo is the context recorded with a snapshot
+type SnapshotInfo struct {
+	GameVersion string
+	Command     string
+	Changes     []string
+}
+
+// Manifest lists the snapshots of every save in a directory, oldest first
+type Manifest struct {
+	SchemaVersion int     `json:"schema_version"`
+	Entries       []Entry `json:"entries"`
+}
+
+// StoreDir returns the backup store used for a save
+func StoreDir(savePath string) string {
+	return filepath.Join(filepath.Dir(savePath), StoreDirName)
+}
+
+// Path returns the path of a snapshot file
+func (e Entry) Path(savePath string) string {
+	return filepath.Join(StoreDir(savePath), e.File)
+}
+
+// LoadManifest reads the manifest of the store used for a save. A missing
+// manifest is an empty store.
+func LoadManifest(savePath string) (*Manifest, error) {
+	return loadManifest(StoreDir(savePath))
+}
+
+// loadManifest reads the manifest of a store directory
+func loadManifest(dir string) (*Manifest, error) {
+	data, err := os.ReadFile(filepath.Join(dir, ManifestName))
+	if errors.Is(err, os.ErrNotExist) {
+		return &Manifest{SchemaVersion: manifestSchemaVersion}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read backup manifest: %w", err)
+	}
+
+	var m Manifest
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil, fmt.Errorf("failed to parse backup manifest: %w", err)
+	}
+	if m.SchemaVersion > manifestSchemaVersion {
+		return nil, fmt.Errorf("backup manifest schema version %d is newer than this raracandy supports (%d)", m.SchemaVersion, manifestSchemaVersion)
+	}
+	return &m, nil
+}
+
+// save writes the manifest of the store used for a save
+func (m *Manifest) save(savePath string) error {
+	m.SchemaVersion = manifestSchemaVersion
+	data, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := atomicfile.WriteFile(filepath.Join(StoreDir(savePath), ManifestName), append(data, '\n'), 0644); err != nil {
+		return fmt.Errorf("failed to write backup manifest: %w", err)
+	}
+	return nil
+}
+
+// For returns the snapshots of a save, newest first
+func (m *Manifest) For(savePath string) []Entry {
+	source := filepath.Base(savePath)
+	entries := make([]Entry, 0, len(m.Entries))
+	for _, e := range m.Entries {
+		if e.Source == source {
+			entries = append(entries, e)
+		}
+	}
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].Created.After(entries[j].Created)
+	})
+	return entries
+}
+
+// Snapshot copies a save into the backup store and records it in the
+// manifest, so earlier snapshots are never overwritten
+func Snapshot(savePath string, info SnapshotInfo) (Entry, error) {
+	data, err := os.ReadFile(savePath)
+	if err != nil {
+		return Entry{}, fmt.Errorf("failed to read source file: %w", err)
+	}
+	return SnapshotData(savePath, data, info)
+}
+
+// SnapshotData stores data, the contents of savePath before an edit, in
+// the backup store like Snapshot
+func SnapshotData(savePath string, data []byte, info SnapshotInfo) (Entry, error) {
+	mode := os.FileMode(0644)
+	if stat, err := os.Stat(savePath); err == nil {
+		mode = stat.Mode().Perm()
+	}
+
+	dir := StoreDir(savePath)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return Entry{}, fmt.Errorf("failed to create backup store: %w", err)
+	}
+
+	m, err := LoadManifest(savePath)
+	if err != nil {
+		return Entry{}, err
+	}
+
+	now := time.Now()
+	entry := Entry{
+		Source:      filepath.Base(savePath),
+		Created:     now,
+		SHA256:      hashBytes(data),
+		GameVersion: info.GameVersion,
+		Command:     info.Command,
+		Changes:     info.Changes,
+	}
+
+	// Reserve a name (two edits within the same second get distinct ones),
+	// then write the snapshot atomically over the empty placeholder
+	name := fmt.Sprintf("%s.%s", entry.Source, now.Format("20060102-150405"))
+	for i := 2; ; i++ {
+		entry.File = name
+		f, err := os.OpenFile(filepath.Join(dir, name), os.O_WRONLY|os.O_CREATE|os.O_EXCL, mode)
+		if errors.Is(err, os.ErrExist) {
+			name = fmt.Sprintf("%s.%s-%d", entry.Source, now.Format("20060102-150405"), i)
+			continue
+		}
+		if err != nil {
+			return Entry{}, fmt.Errorf("failed to create snapshot: %w", err)
+		}
+		f.Close()
+		break
+	}
+	if err := atomicfile.WriteFile(entry.Path(savePath), data, mode); err != nil {
+		os.Remove(entry.Path(savePath))
+		return Entry{}, fmt.Errorf("failed to write snapshot: %w", err)
+	}
+
+	m.Entries = append(m.Entries, entry)
+	if err := m.save(savePath); err != nil {
+		os.Remove(entry.Path(savePath))
+		return Entry{}, err
+	}
+	return entry, nil
+}
+
+// PruneFilter selects the snapshots Prune removes: everything but the Keep
+// newest ones (when Keep > 0) that is also older than OlderThan (when set)
+type PruneFilter struct {
+	Keep      int
+	OlderThan time.Duration
+}
+
+// Select returns the snapshots of a save that the filter removes
+func (f PruneFilter) Select(entries []Entry, now time.Time) []Entry {
+	// entries are newest first, as returned by Manifest.For
+	var selected []Entry
+	for i, e := range entries {
+		if f.Keep > 0 && i < f.Keep {
+			continue
+		}
+		if f.OlderThan > 0 && now.Sub(e.Created) < f.OlderThan {
+			continue
+		}
+		selected = append(selected, e)
+	}
+	return selected
+}
+
+// Prune deletes snapshots of a save and removes them from the manifest
+func Prune(savePath string, remove []Entry) error {
+	m, err := LoadManifest(savePath)
+	if err != nil {
+		return err
+	}
+
+	drop := make(map[string]bool, len(remove))
+	for _, e := range remove {
+		drop[e.File] = true
+	}
+
+	kept := m.Entries[:0]
+	for _, e := range m.Entries {
+		if drop[e.File] {
+			continue
+		}
+		kept = append(kept, e)
+	}
+	m.Entries = kept
+
+	// Update the manifest first, so an interrupted prune leaves stray
+	// files rather than entries without a snapshot
+	if err := m.save(savePath); err != nil {
+		return err
+	}
+	for _, e := range remove {
+		if err := os.Remove(e.Path(savePath)); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("failed to delete %s: %w", e.File, err)
+		}
+	}
+	return nil
+}
+
+// manifestHash returns the hash the manifest next to a snapshot records
+// for it
+func manifestHash(snapshotPath string) (string, error) {
+	m, err := loadManifest(filepath.Dir(snapshotPath))
+	if err != nil {
+		return "", err
+	}
+	name := filepath.Base(snapshotPath)
+	for _, e := range m.Entries {
+		if e.File == name {
+			return e.SHA256, nil
+		}
+	}
+	return "", fmt.Errorf("%s is not in the backup manifest", name)
+}
+
+// Verify checks a snapshot file against the hash in the manifest
+func (e Entry) Verify(savePath string) error {
+	hash, err := FileHash(e.Path(savePath))
+	if err != nil {
+		return fmt.Errorf("snapshot file is missing")
+	}
+	if hash != e.SHA256 {
+		return fmt.Errorf("SHA-256 does not match the backup manifest - the snapshot was modified")
+	}
+	return nil
+}
@@ -0,0 +1,114 @@
+package backup
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestSnapshot(t *testing.T) {
+	dir := t.TempDir()
+	target := filepath.Join(dir, "pokemon.sav")
+	other := filepath.Join(dir, "other.sav")
+	os.WriteFile(target, []byte("first"), 0600)
+	os.WriteFile(other, []byte("other"), 0644)
+
+	first, err := Snapshot(target, SnapshotInfo{GameVersion: "Pokémon Yellow (North America)", Command: "raracandy set-money", Changes: []string{"Set money to ₽1,000"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	os.WriteFile(target, []byte("second"), 0644)
+	second, err := Snapshot(target, SnapshotInfo{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Snapshot(other, SnapshotInfo{}); err != nil {
+		t.Fatal(err)
+	}
+
+	if first.File == second.File {
+		t.Fatalf("snapshots share the file name %s", first.File)
+	}
+	if data, _ := os.ReadFile(first.Path(target)); string(data) != "first" {
+		t.Errorf("first snapshot = %q, want %q", data, "first")
+	}
+	if info, _ := os.Stat(first.Path(target)); info.Mode().Perm() != 0600 {
+		t.Errorf("snapshot mode = %v, want the save's 0600", info.Mode().Perm())
+	}
+
+	m, err := LoadManifest(target)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(m.Entries) != 3 {
+		t.Fatalf("manifest has %d entries, want 3", len(m.Entries))
+	}
+	entries := m.For(target)
+	if len(entries) != 2 || entries[0].File != second.File {
+		t.Fatalf("For() = %+v, want the two pokemon.sav snapshots, newest first", entries)
+	}
+	if entries[1].Command != "raracandy set-money" || entries[1].Changes[0] != "Set money to ₽1,000" || entries[1].SHA256 != hashBytes([]byte("first")) {
+		t.Errorf("first entry = %+v", entries[1])
+	}
+	for _, e := range entries {
+		if err := e.Verify(target); err != nil {
+			t.Errorf("Verify(%s) = %v", e.File, err)
+		}
+	}
+}
+
+func TestPrune(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	entries := []Entry{
+		{File: "a", Created: now.Add(-1 * time.Hour)},
+		{File: "b", Created: now.Add(-10 * 24 * time.Hour)},
+		{File: "c", Created: now.Add(-40 * 24 * time.Hour)},
+		{File: "d", Created: now.Add(-50 * 24 * time.Hour)},
+	}
+	tests := []struct {
+		filter PruneFilter
+		want   string
+	}{
+		{PruneFilter{Keep: 2}, "cd"},
+		{PruneFilter{OlderThan: 30 * 24 * time.Hour}, "cd"},
+		{PruneFilter{Keep: 3, OlderThan: 30 * 24 * time.Hour}, "d"},
+		{PruneFilter{Keep: 1, OlderThan: 5 * 24 * time.Hour}, "bcd"},
+		{PruneFilter{Keep: 10}, ""},
+	}
+	for _, tt := range tests {
+		got := ""
+		for _, e := range tt.filter.Select(entries, now) {
+			got += e.File
+		}
+		if got != tt.want {
+			t.Errorf("%+v.Select() = %q, want %q", tt.filter, got, tt.want)
+		}
+	}
+
+	dir := t.TempDir()
+	target := filepath.Join(dir, "pokemon.sav")
+	os.WriteFile(target, []byte("save"), 0644)
+	var snapshots []Entry
+	for i := 0; i < 3; i++ {
+		e, err := Snapshot(target, SnapshotInfo{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		snapshots = append(snapshots, e)
+	}
+
+	if err := Prune(target, snapshots[:2]); err != nil {
+		t.Fatal(err)
+	}
+	m, _ := LoadManifest(target)
+	if len(m.Entries) != 1 || m.Entries[0].File != snapshots[2].File {
+		t.Errorf("manifest after prune = %+v", m.Entries)
+	}
+	for i, e := range snapshots {
+		_, err := os.Stat(e.Path(target))
+		if exists := err == nil; exists != (i == 2) {
+			t.Errorf("snapshot %s exists = %v after prune", e.File, exists)
+		}
+	}
+}
@@ -0,0 +1,122 @@
+package edit
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/abravonunez/raracandy/internal/backup"
+	"github.com/abravonunez/raracandy/internal/gen1/save"
+)
+
+// Edit describes a mutating command for Run
+type Edit struct {
+	Path    string
+	Output  string
+	DryRun  bool
+	Force   bool
+	Load    save.LoadOptions
+	Command string // recorded with the backup snapshot
+
+	// Plan inspects the loaded save, prints the details of the planned
+	// changes and registers them with tx.Add
+	Plan func(tx *Transaction) error
+
+	// Confirm asks before anything is written; save.ConfirmWithDetails
+	// when nil
+	Confirm func(changes []string) bool
+	// Out receives the progress messages; os.Stdout when nil
+	Out io.Writer
+}
+
+// Run takes a save through the integrity check → plan → confirm → apply →
+// backup → write → verify pipeline shared by every mutating command
+func Run(e Edit) error {
+	out := e.Out
+	if out == nil {
+		out = os.Stdout
+	}
+	confirm := e.Confirm
+	if confirm == nil {
+		confirm = save.ConfirmWithDetails
+	}
+
+	// Load save file
+	fmt.Fprintln(out, "⚙️  Loading save...")
+	tx, err := Begin(e.Path, e.Load)
+	if err != nil {
+		return fmt.Errorf("failed to load save: %w", err)
+	}
+	s := tx.Save
+
+	// Perform integrity check
+	fmt.Fprintln(out, "🔍 Running integrity check...")
+	report := s.CheckIntegrity()
+
+	if !report.IsValid {
+		fmt.Fprintln(out, "\n❌ Save file integrity check failed:")
+		for _, err := range report.Errors {
+			fmt.Fprintf(out, "  • %s\n", err)
+		}
+		return fmt.Errorf("cannot modify corrupted save file")
+	}
+
+	fmt.Fprintf(out, "✓ Integrity check passed\n")
+	fmt.Fprintf(out, "✓ Detected: %s\n", report.GameVersion)
+	fmt.Fprintln(out)
+
+	// Preview changes
+	fmt.Fprintln(out, "Changes to be applied:")
+	if err := e.Plan(tx); err != nil {
+		return err
+	}
+
+	oldChecksum := s.GetChecksum()
+	fmt.Fprintf(out, "  Checksum: 0x%02X → (will recalculate)\n", oldChecksum)
+
+	if e.DryRun {
+		fmt.Fprintln(out, "\n[DRY RUN] No changes written")
+		return nil
+	}
+
+	// Ask for confirmation if not in force mode
+	if !e.Force {
+		if !confirm(append(tx.Descriptions(), "Recalculate checksum")) {
+			fmt.Fprintln(out, "\n❌ Operation cancelled by user")
+			return nil
+		}
+	}
+
+	// Apply changes
+	fmt.Fprintln(out, "\n✍️  Applying changes...")
+	if err := tx.Apply(); err != nil {
+		return err
+	}
+
+	// Back up the save as it was loaded
+	fmt.Fprintln(out, "💾 Creating backup...")
+	entry, err := tx.Backup(backup.SnapshotInfo{
+		GameVersion: report.GameVersion.String(),
+		Command:     e.Command,
+		Changes:     tx.Descriptions(),
+	})
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "✓ Backup created: %s\n", backup.GetBackupPath(tx.Path))
+	fmt.Fprintf(out, "✓ Backup hash saved: %s (%s)\n", backup.SidecarPath(backup.GetBackupPath(tx.Path)), tx.OriginalHash())
+	fmt.Fprintf(out, "✓ Snapshot stored: %s\n", entry.Path(tx.Path))
+
+	// Write output and verify it
+	if err := tx.Commit(e.Output); err != nil {
+		return err
+	}
+
+	newChecksum := s.GetChecksum()
+	fmt.Fprintf(out, "\n✓ Save written: %s\n", e.Output)
+	fmt.Fprintf(out, "✓ Checksum updated: 0x%02X → 0x%02X\n", oldChecksum, newChecksum)
+	fmt.Fprintf(out, "✓ Verification passed\n")
+	fmt.Fprintf(out, "\n🎉 Success! Your save is ready to use.")
+
+	return nil
+}
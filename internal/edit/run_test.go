@@ -0,0 +1,124 @@
+package edit
+
+import (
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/abravonunez/raracandy/internal/backup"
+	"github.com/abravonunez/raracandy/internal/gen1/money"
+	"github.com/abravonunez/raracandy/internal/gen1/save"
+)
+
+// testEdit writes a fresh save and returns an Edit of it that sets the
+// money and then the coins
+func testEdit(t *testing.T) (Edit, []byte) {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "pokemon.sav")
+	if err := save.CreateTestSave().Write(path); err != nil {
+		t.Fatal(err)
+	}
+	original, _ := os.ReadFile(path)
+
+	return Edit{
+		Path:   path,
+		Output: path,
+		Force:  true,
+		Out:    io.Discard,
+		Plan: func(tx *Transaction) error {
+			tx.Add("Set money", func(s *save.Save) error { return money.SetMoney(s, 5000) })
+			tx.Add("Set coins", func(s *save.Save) error { return money.SetCoins(s, 50) })
+			return nil
+		},
+	}, original
+}
+
+func TestRun(t *testing.T) {
+	e, original := testEdit(t)
+	var asked []string
+	e.Force = false
+	e.Confirm = func(changes []string) bool {
+		asked = changes
+		return true
+	}
+	if err := Run(e); err != nil {
+		t.Fatal(err)
+	}
+
+	if want := []string{"Set money", "Set coins", "Recalculate checksum"}; !reflect.DeepEqual(asked, want) {
+		t.Errorf("confirmation listed %q, want %q", asked, want)
+	}
+
+	s, err := save.Load(e.Path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if money.GetMoney(s) != 5000 || money.GetCoins(s) != 50 {
+		t.Errorf("written save has money %d and coins %d, want 5000 and 50", money.GetMoney(s), money.GetCoins(s))
+	}
+	if bak, _ := os.ReadFile(backup.GetBackupPath(e.Path)); string(bak) != string(original) {
+		t.Error(".bak does not hold the original save")
+	}
+	m, _ := backup.LoadManifest(e.Path)
+	if entries := m.For(e.Path); len(entries) != 1 || !reflect.DeepEqual(entries[0].Changes, []string{"Set money", "Set coins"}) {
+		t.Errorf("snapshot entries = %+v", entries)
+	}
+}
+
+func TestRunWritesNothing(t *testing.T) {
+	tests := []struct {
+		name  string
+		setup func(e *Edit)
+		fails bool
+	}{
+		{"dry run", func(e *Edit) { e.DryRun = true }, false},
+		{"cancelled", func(e *Edit) {
+			e.Force = false
+			e.Confirm = func([]string) bool { return false }
+		}, false},
+		{"plan error", func(e *Edit) {
+			e.Plan = func(tx *Transaction) error { return errors.New("invalid slot") }
+		}, true},
+		{"change error", func(e *Edit) {
+			plan := e.Plan
+			e.Plan = func(tx *Transaction) error {
+				plan(tx)
+				tx.Add("Fail", func(s *save.Save) error { return errors.New("no room") })
+				return nil
+			}
+		}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e, original := testEdit(t)
+			tt.setup(&e)
+			if err := Run(e); (err != nil) != tt.fails {
+				t.Fatalf("Run() error = %v, want error %v", err, tt.fails)
+			}
+			if data, _ := os.ReadFile(e.Path); string(data) != string(original) {
+				t.Error("the save was modified")
+			}
+			if _, err := os.Stat(backup.GetBackupPath(e.Path)); err == nil {
+				t.Error("a backup was written")
+			}
+		})
+	}
+}
+
+func TestRunRefusesCorruptedSave(t *testing.T) {
+	e, _ := testEdit(t)
+	data, _ := os.ReadFile(e.Path)
+	data[save.OffsetChecksum] ^= 0xFF
+	os.WriteFile(e.Path, data, 0644)
+
+	if err := Run(e); err == nil {
+		t.Fatal("Run() should refuse a save with a bad checksum")
+	}
+	if got, _ := os.ReadFile(e.Path); string(got) != string(data) {
+		t.Error("the corrupted save was modified")
+	}
+}
@@ -0,0 +1,117 @@
+// Package edit runs the changes a command makes to a save as a
+// transaction: commands register changes, and Run takes care of the
+// integrity check, preview, confirmation, backup, write and verification.
+// The backup always holds the save as it was loaded.
+package edit
+
+import (
+	"fmt"
+
+	"github.com/abravonunez/raracandy/internal/backup"
+	"github.com/abravonunez/raracandy/internal/gen1/save"
+)
+
+// Change is one modification registered with a transaction
+type Change struct {
+	Description string // shown when confirming and recorded with the backup
+	Apply       func(s *save.Save) error
+}
+
+// Transaction is a save being edited together with a snapshot of the bytes
+// and hash it was loaded with
+type Transaction struct {
+	Path string
+	Save *save.Save
+
+	opts         save.LoadOptions
+	original     []byte
+	originalHash string
+	changes      []Change
+}
+
+// Begin loads a save and snapshots its original bytes
+func Begin(path string, opts save.LoadOptions) (*Transaction, error) {
+	s, err := save.LoadWith(path, opts)
+	if err != nil {
+		return nil, err
+	}
+	return &Transaction{
+		Path:         path,
+		Save:         s,
+		opts:         opts,
+		original:     s.Data(),
+		originalHash: s.GetSHA256(),
+	}, nil
+}
+
+// Add registers a change, applied after the changes registered before it
+func (t *Transaction) Add(description string, apply func(s *save.Save) error) {
+	t.changes = append(t.changes, Change{Description: description, Apply: apply})
+}
+
+// Changes returns the registered changes
+func (t *Transaction) Changes() []Change {
+	return t.changes
+}
+
+// Descriptions returns the description of every registered change
+func (t *Transaction) Descriptions() []string {
+	descriptions := make([]string, 0, len(t.changes))
+	for _, c := range t.changes {
+		descriptions = append(descriptions, c.Description)
+	}
+	return descriptions
+}
+
+// Apply applies the registered changes to the save in order
+func (t *Transaction) Apply() error {
+	for _, c := range t.changes {
+		if err := c.Apply(t.Save); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// OriginalHash returns the SHA-256 of the save as it was loaded
+func (t *Transaction) OriginalHash() string {
+	return t.originalHash
+}
+
+// Backup writes the original bytes to <path>.bak with a verified hash
+// sidecar, and stores them in the backup store with info
+func (t *Transaction) Backup(info backup.SnapshotInfo) (backup.Entry, error) {
+	hash, err := backup.CreateBackupWithHash(t.Path, t.original)
+	if err != nil {
+		return backup.Entry{}, fmt.Errorf("failed to create backup: %w", err)
+	}
+	if hash != t.originalHash {
+		return backup.Entry{}, fmt.Errorf("failed to create backup: hash %s does not match the loaded save %s", hash, t.originalHash)
+	}
+
+	entry, err := backup.SnapshotData(t.Path, t.original, info)
+	if err != nil {
+		return backup.Entry{}, fmt.Errorf("failed to store snapshot: %w", err)
+	}
+	return entry, nil
+}
+
+// Commit writes the edited save to output and reloads it to check that
+// the file on disk is exactly what was written
+func (t *Transaction) Commit(output string) error {
+	if err := t.Save.Write(output); err != nil {
+		return fmt.Errorf("failed to write save: %w", err)
+	}
+
+	written, err := save.LoadWith(output, t.opts)
+	if err != nil {
+		return fmt.Errorf("failed to verify written file: %w", err)
+	}
+	if !written.ValidateChecksum() {
+		return fmt.Errorf("verification failed: checksum invalid after write")
+	}
+	if written.GetSHA256() != t.Save.GetSHA256() {
+		return fmt.Errorf("verification failed: written file differs from the edited save")
+	}
+	return nil
+}
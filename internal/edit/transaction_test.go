@@ -0,0 +1,62 @@
+package edit
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/abravonunez/raracandy/internal/backup"
+	"github.com/abravonunez/raracandy/internal/gen1/money"
+	"github.com/abravonunez/raracandy/internal/gen1/save"
+)
+
+func TestTransactionBacksUpOriginalBytes(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "pokemon.sav")
+	if err := save.CreateTestSave().Write(path); err != nil {
+		t.Fatal(err)
+	}
+	original, _ := os.ReadFile(path)
+
+	tx, err := Begin(path, save.LoadOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := money.SetMoney(tx.Save, 123456); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := tx.Backup(backup.SnapshotInfo{Command: "raracandy set-money"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := tx.Commit(path); err != nil {
+		t.Fatal(err)
+	}
+
+	// The backup and its sidecar hold the pre-edit save, even though the
+	// edit happened before the backup and overwrote the source
+	bak, _ := os.ReadFile(backup.GetBackupPath(path))
+	if string(bak) != string(original) {
+		t.Error(".bak does not hold the original save")
+	}
+	b, err := backup.Inspect(backup.GetBackupPath(path))
+	if err != nil || !b.Verified() || b.Hash != tx.OriginalHash() {
+		t.Errorf("Inspect(.bak) = %+v, %v, want verified with hash %s", b, err, tx.OriginalHash())
+	}
+	if ok, _ := backup.VerifyBackupHash(path, tx.OriginalHash()); !ok {
+		t.Error("VerifyBackupHash() should accept the original hash")
+	}
+
+	m, _ := backup.LoadManifest(path)
+	entries := m.For(path)
+	if len(entries) != 1 || entries[0].SHA256 != tx.OriginalHash() || entries[0].Verify(path) != nil {
+		t.Errorf("store entries = %+v, want one verified snapshot of the original", entries)
+	}
+
+	edited, err := save.Load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := money.GetMoney(edited); got != 123456 {
+		t.Errorf("written money = %d, want 123456", got)
+	}
+}
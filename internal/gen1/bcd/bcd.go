@@ -0,0 +1,79 @@
+package bcd
+
+import "fmt"
+
+// Decode converts big-endian packed BCD (two decimal digits per byte) into
+// its value. Nibbles above 9 are reported as an error; the value is still
+// computed from them so callers can show what the game would display.
+func Decode(b []byte) (uint32, error) {
+	var value uint32
+	var err error
+	for i, x := range b {
+		if !ValidByte(x) && err == nil {
+			err = fmt.Errorf("invalid BCD byte %d: 0x%02X", i, x)
+		}
+		value = value*100 + uint32(DecodeByte(x))
+	}
+	return value, err
+}
+
+// Encode converts a value into n bytes of big-endian packed BCD
+func Encode(value uint32, n int) ([]byte, error) {
+	if value > Max(n) {
+		return nil, fmt.Errorf("%d does not fit in %d BCD bytes (maximum %d)", value, n, Max(n))
+	}
+	b := make([]byte, n)
+	for i := n - 1; i >= 0; i-- {
+		b[i] = EncodeByte(byte(value % 100))
+		value /= 100
+	}
+	return b, nil
+}
+
+// Valid reports whether every nibble is a decimal digit
+func Valid(b []byte) bool {
+	for _, x := range b {
+		if !ValidByte(x) {
+			return false
+		}
+	}
+	return true
+}
+
+// InvalidBytes returns the indexes of the bytes holding a nibble above 9
+func InvalidBytes(b []byte) []int {
+	invalid := make([]int, 0)
+	for i, x := range b {
+		if !ValidByte(x) {
+			invalid = append(invalid, i)
+		}
+	}
+	return invalid
+}
+
+// Max returns the largest value n BCD bytes can hold (99, 9999, 999999...)
+func Max(n int) uint32 {
+	max := uint32(1)
+	for i := 0; i < n; i++ {
+		max *= 100
+	}
+	return max - 1
+}
+
+// ValidByte reports whether both nibbles of a byte are decimal digits
+func ValidByte(x byte) bool {
+	return x>>4 <= 9 && x&0x0F <= 9
+}
+
+// DecodeByte converts one BCD byte to decimal (0x23 → 23)
+func DecodeByte(x byte) byte {
+	return (x>>4)*10 + x&0x0F
+}
+
+// EncodeByte converts a decimal byte to BCD (23 → 0x23), capping at 99
+func EncodeByte(d byte) byte {
+	if d > 99 {
+		d = 99
+	}
+	return (d/10)<<4 | d%10
+}
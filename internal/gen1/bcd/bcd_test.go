@@ -0,0 +1,67 @@
+package bcd
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestEncodeDecode(t *testing.T) {
+	tests := []struct {
+		value uint32
+		n     int
+		bcd   []byte
+	}{
+		{0, 2, []byte{0x00, 0x00}},
+		{1234, 2, []byte{0x12, 0x34}},
+		{9999, 2, []byte{0x99, 0x99}},
+		{3000, 3, []byte{0x00, 0x30, 0x00}},
+		{999999, 3, []byte{0x99, 0x99, 0x99}},
+	}
+
+	for _, tt := range tests {
+		got, err := Encode(tt.value, tt.n)
+		if err != nil {
+			t.Fatalf("Encode(%d, %d) error: %v", tt.value, tt.n, err)
+		}
+		if !bytes.Equal(got, tt.bcd) {
+			t.Errorf("Encode(%d, %d) = % X, want % X", tt.value, tt.n, got, tt.bcd)
+		}
+
+		value, err := Decode(tt.bcd)
+		if err != nil {
+			t.Fatalf("Decode(% X) error: %v", tt.bcd, err)
+		}
+		if value != tt.value {
+			t.Errorf("Decode(% X) = %d, want %d", tt.bcd, value, tt.value)
+		}
+	}
+}
+
+func TestEncodeOverflow(t *testing.T) {
+	if _, err := Encode(10000, 2); err == nil {
+		t.Error("Encode(10000, 2) should fail")
+	}
+	if _, err := Encode(1000000, 3); err == nil {
+		t.Error("Encode(1000000, 3) should fail")
+	}
+}
+
+func TestInvalidNibbles(t *testing.T) {
+	data := []byte{0x12, 0x3A, 0xF0}
+
+	if Valid(data) {
+		t.Error("Valid() = true for nibbles above 9")
+	}
+	if got := InvalidBytes(data); len(got) != 2 || got[0] != 1 || got[1] != 2 {
+		t.Errorf("InvalidBytes() = %v, want [1 2]", got)
+	}
+	if _, err := Decode(data); err == nil {
+		t.Error("Decode() should report invalid nibbles")
+	}
+}
+
+func TestMax(t *testing.T) {
+	if Max(1) != 99 || Max(2) != 9999 || Max(3) != 999999 {
+		t.Errorf("Max() = %d/%d/%d", Max(1), Max(2), Max(3))
+	}
+}
@@ -0,0 +1,159 @@
+package box
+
+import (
+	"fmt"
+
+	"github.com/abravonunez/raracandy/internal/gen1/party"
+	"github.com/abravonunez/raracandy/internal/gen1/save"
+)
+
+const (
+	// SpeciesListTerminator ends a box species list
+	SpeciesListTerminator = 0xFF
+)
+
+// BoxMon is a Pokémon stored in a PC box, with its OT name and nickname
+// (raw, 0x50-terminated)
+type BoxMon struct {
+	party.Mon
+	OTName   []byte
+	Nickname []byte
+}
+
+// layout holds the offsets of each sub-block of one box
+type layout struct {
+	count    int
+	species  int
+	structs  int
+	otNames  int
+	nicks    int
+	maxMons  int
+	nameSize int
+}
+
+func getLayout(s *save.Save, base int) layout {
+	prof := s.GetProfile()
+	l := layout{
+		count:    base,
+		species:  base + 1,
+		maxMons:  prof.MaxBoxMons,
+		nameSize: prof.NameLength,
+	}
+	l.structs = l.species + l.maxMons + 1
+	l.otNames = l.structs + l.maxMons*party.MonSize
+	l.nicks = l.otNames + l.maxMons*l.nameSize
+	return l
+}
+
+// CurrentBox returns the 0-based index of the box currently selected in the PC
+func CurrentBox(s *save.Save) int {
+	prof := s.GetProfile()
+	return int(s.GetByte(prof.OffsetCurrentBoxNum) & 0x7F)
+}
+
+// GetBox returns the Pokémon stored in a box (0-based). The current box is
+// read from its working copy in bank 1; the others from banks 2/3.
+func GetBox(s *save.Save, box int) ([]BoxMon, error) {
+	base, err := readOffset(s, box)
+	if err != nil {
+		return nil, err
+	}
+	if base < 0 {
+		return []BoxMon{}, nil
+	}
+
+	l := getLayout(s, base)
+	count := int(s.GetByte(l.count))
+	if count > l.maxMons {
+		count = l.maxMons
+	}
+
+	mons := make([]BoxMon, 0, count)
+	for i := 0; i < count; i++ {
+		mons = append(mons, BoxMon{
+			Mon:      party.DecodeMon(s.GetBytes(l.structs+i*party.MonSize, party.MonSize)),
+			OTName:   s.GetBytes(l.otNames+i*l.nameSize, l.nameSize),
+			Nickname: s.GetBytes(l.nicks+i*l.nameSize, l.nameSize),
+		})
+	}
+	return mons, nil
+}
+
+// SetBox replaces the contents of a box (0-based). Writing the current box
+// updates both its working copy in bank 1 and its slot in banks 2/3.
+// Checksums are updated when the save is written.
+func SetBox(s *save.Save, box int, mons []BoxMon) error {
+	prof := s.GetProfile()
+	if box < 0 || box >= prof.BoxCount {
+		return fmt.Errorf("box %d out of range (1-%d)", box+1, prof.BoxCount)
+	}
+	if len(mons) > prof.MaxBoxMons {
+		return fmt.Errorf("box %d can hold at most %d Pokémon, got %d", box+1, prof.MaxBoxMons, len(mons))
+	}
+
+	targets := make([]int, 0, 2)
+	if box == CurrentBox(s) {
+		targets = append(targets, prof.OffsetCurrentBox)
+	}
+	if s.BoxesInitialized() {
+		targets = append(targets, prof.BoxOffset(box))
+	} else if box != CurrentBox(s) {
+		return fmt.Errorf("PC boxes are not initialized yet - change boxes once in-game before editing box %d", box+1)
+	}
+
+	data := encodeBox(s, mons)
+	for _, offset := range targets {
+		if err := s.SetBytes(offset, data); err != nil {
+			return fmt.Errorf("failed to write box %d: %w", box+1, err)
+		}
+	}
+	return nil
+}
+
+// readOffset returns where the authoritative copy of a box lives, or -1
+// when the box has never been initialized and is therefore empty
+func readOffset(s *save.Save, box int) (int, error) {
+	prof := s.GetProfile()
+	if box < 0 || box >= prof.BoxCount {
+		return 0, fmt.Errorf("box %d out of range (1-%d)", box+1, prof.BoxCount)
+	}
+	if box == CurrentBox(s) {
+		return prof.OffsetCurrentBox, nil
+	}
+	if !s.BoxesInitialized() {
+		return -1, nil
+	}
+	return prof.BoxOffset(box), nil
+}
+
+// encodeBox builds the full box block: count, terminated species list,
+// structs, OT names and nicknames. Unused slots are filled like the game does.
+func encodeBox(s *save.Save, mons []BoxMon) []byte {
+	prof := s.GetProfile()
+	l := getLayout(s, 0)
+	data := make([]byte, prof.BoxSize())
+
+	data[l.count] = byte(len(mons))
+	for i := 0; i <= l.maxMons; i++ {
+		data[l.species+i] = SpeciesListTerminator
+	}
+	for i := l.otNames; i < len(data); i++ {
+		data[i] = 0x50
+	}
+
+	for i, mon := range mons {
+		data[l.species+i] = mon.Species
+		copy(data[l.structs+i*party.MonSize:], mon.Mon.Encode())
+		copyName(data[l.otNames+i*l.nameSize:l.otNames+(i+1)*l.nameSize], mon.OTName)
+		copyName(data[l.nicks+i*l.nameSize:l.nicks+(i+1)*l.nameSize], mon.Nickname)
+	}
+
+	return data
+}
+
+func copyName(dst, name []byte) {
+	for i := range dst {
+		dst[i] = 0x50
+	}
+	copy(dst, name)
+}
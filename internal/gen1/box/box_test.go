@@ -0,0 +1,91 @@
+package box
+
+import (
+	"testing"
+
+	"github.com/abravonunez/raracandy/internal/gen1/party"
+	"github.com/abravonunez/raracandy/internal/gen1/save"
+)
+
+func testMon(species, level byte) BoxMon {
+	return BoxMon{
+		Mon:      party.Mon{Species: species, BoxLevel: level, Moves: [4]byte{0x21}},
+		OTName:   []byte{0x80, 0x81, 0x50},
+		Nickname: []byte{0x82, 0x50},
+	}
+}
+
+func TestCurrentBoxBeforeInitialization(t *testing.T) {
+	s := save.CreateTestSave()
+
+	if err := SetBox(s, 0, []BoxMon{testMon(0x54, 5)}); err != nil {
+		t.Fatalf("SetBox(current) error = %v", err)
+	}
+	if err := SetBox(s, 6, []BoxMon{testMon(0x54, 5)}); err == nil {
+		t.Error("SetBox() on an uninitialized bank box should fail")
+	}
+
+	mons, err := GetBox(s, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(mons) != 1 || mons[0].Species != 0x54 {
+		t.Errorf("GetBox(0) = %+v, want one Pikachu", mons)
+	}
+
+	other, err := GetBox(s, 3)
+	if err != nil || len(other) != 0 {
+		t.Errorf("GetBox(3) = %v, %v; want empty box", other, err)
+	}
+}
+
+func TestBoxesAcrossBanks(t *testing.T) {
+	s := save.CreateTestSave()
+	prof := s.GetProfile()
+	s.SetByte(prof.OffsetCurrentBoxNum, 0x80) // initialized, box 1 selected
+	for box := 0; box < prof.BoxCount; box++ {
+		if err := SetBox(s, box, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := SetBox(s, 2, []BoxMon{testMon(0x99, 10)}); err != nil {
+		t.Fatal(err)
+	}
+	if err := SetBox(s, 11, []BoxMon{testMon(0x54, 20), testMon(0xB0, 30)}); err != nil {
+		t.Fatal(err)
+	}
+
+	mons, _ := GetBox(s, 11)
+	if len(mons) != 2 || mons[1].BoxLevel != 30 {
+		t.Errorf("GetBox(11) = %+v, want two Pokémon with the second at level 30", mons)
+	}
+	if s.GetByte(prof.BoxOffset(11)) != 2 {
+		t.Errorf("box 12 is not stored in bank 3")
+	}
+
+	s.RecalculateChecksum()
+	report := s.CheckIntegrity()
+	if !report.IsValid {
+		t.Fatalf("CheckIntegrity() errors = %v", report.Errors)
+	}
+	// main + 2 banks + 12 boxes
+	if len(report.Checksums) != 15 {
+		t.Errorf("got %d checksum statuses, want 15", len(report.Checksums))
+	}
+
+	// Corrupting one box must be reported for its bank and the box itself
+	s.SetByte(prof.BoxOffset(11)+1, 0x01)
+	report = s.CheckIntegrity()
+	if report.IsValid || len(report.Errors) != 2 {
+		t.Errorf("CheckIntegrity() errors = %v, want bank 3 and box 12 errors", report.Errors)
+	}
+}
+
+func TestSetBoxRejectsOverflow(t *testing.T) {
+	s := save.CreateTestSave()
+	mons := make([]BoxMon, s.GetProfile().MaxBoxMons+1)
+	if err := SetBox(s, 0, mons); err == nil {
+		t.Error("SetBox() with too many Pokémon should fail")
+	}
+}
@@ -0,0 +1,136 @@
+package daycare
+
+import (
+	"fmt"
+
+	"github.com/abravonunez/raracandy/internal/gen1/box"
+	"github.com/abravonunez/raracandy/internal/gen1/party"
+	"github.com/abravonunez/raracandy/internal/gen1/save"
+	"github.com/abravonunez/raracandy/internal/gen1/species"
+)
+
+// layout holds the offsets of the daycare fields for the save's profile
+type layout struct {
+	inUse    int
+	nickname int
+	otName   int
+	mon      int
+	nameSize int
+}
+
+func getLayout(s *save.Save) layout {
+	prof := s.GetProfile()
+	l := layout{
+		inUse:    prof.OffsetDaycare,
+		nickname: prof.OffsetDaycare + 1,
+		nameSize: prof.NameLength,
+	}
+	l.otName = l.nickname + l.nameSize
+	l.mon = l.otName + l.nameSize
+	return l
+}
+
+// InUse reports whether a Pokémon is being raised in the daycare
+func InUse(s *save.Save) bool {
+	return s.GetByte(getLayout(s).inUse) != 0
+}
+
+// Get returns the Pokémon in the daycare, with its nickname and OT name
+// (raw, 0x50-terminated)
+func Get(s *save.Save) (box.BoxMon, error) {
+	if !InUse(s) {
+		return box.BoxMon{}, fmt.Errorf("the daycare is empty")
+	}
+
+	l := getLayout(s)
+	return box.BoxMon{
+		Mon:      party.DecodeMon(s.GetBytes(l.mon, party.MonSize)),
+		OTName:   s.GetBytes(l.otName, l.nameSize),
+		Nickname: s.GetBytes(l.nickname, l.nameSize),
+	}, nil
+}
+
+// Set puts a Pokémon in the daycare, replacing any Pokémon already there
+func Set(s *save.Save, mon box.BoxMon) error {
+	l := getLayout(s)
+	if err := s.SetBytes(l.mon, mon.Mon.Encode()); err != nil {
+		return fmt.Errorf("failed to write daycare struct: %w", err)
+	}
+	if err := s.SetBytes(l.nickname, padName(mon.Nickname, l.nameSize)); err != nil {
+		return fmt.Errorf("failed to write daycare nickname: %w", err)
+	}
+	if err := s.SetBytes(l.otName, padName(mon.OTName, l.nameSize)); err != nil {
+		return fmt.Errorf("failed to write daycare OT name: %w", err)
+	}
+	return s.SetByte(l.inUse, 1)
+}
+
+// Deposit moves a party Pokémon (0-based slot) into the empty daycare. As
+// in the game, the last Pokémon of the party cannot be left there.
+func Deposit(s *save.Save, slot int) error {
+	if InUse(s) {
+		return fmt.Errorf("the daycare is already raising a Pokémon")
+	}
+	mons := party.GetParty(s)
+	if slot < 0 || slot >= len(mons) {
+		return fmt.Errorf("party slot %d is empty (party has %d Pokémon)", slot+1, len(mons))
+	}
+	if len(mons) == 1 {
+		return fmt.Errorf("cannot deposit the only Pokémon in the party")
+	}
+
+	mon := mons[slot]
+	remaining := append(mons[:slot:slot], mons[slot+1:]...)
+	if err := party.SetParty(s, remaining); err != nil {
+		return err
+	}
+	return Set(s, box.BoxMon{Mon: mon.Mon, OTName: mon.OTName, Nickname: mon.Nickname})
+}
+
+// GrownLevel returns the level of the daycare Pokémon as it would leave the
+// daycare: the level its experience reaches (at most 100), as the game works
+// it out on withdrawal. Unknown species keep the level in the structure.
+func GrownLevel(mon box.BoxMon) int {
+	sp, ok := species.ByIndex(mon.Species)
+	if !ok {
+		return int(mon.BoxLevel)
+	}
+	return sp.GrowthRate.LevelForExp(mon.Exp)
+}
+
+// Withdraw moves the daycare Pokémon to the end of the party. Its level is
+// worked out from its experience, so it keeps the levels gained in the
+// daycare, and its stats are recalculated for that level.
+func Withdraw(s *save.Save) error {
+	mon, err := Get(s)
+	if err != nil {
+		return err
+	}
+	mons := party.GetParty(s)
+	if len(mons) >= s.GetProfile().MaxPartyMons {
+		return fmt.Errorf("the party is full (%d Pokémon)", len(mons))
+	}
+
+	level := byte(GrownLevel(mon))
+	mon.BoxLevel = level
+	withdrawn := party.PartyMon{Mon: mon.Mon, Level: level, OTName: mon.OTName, Nickname: mon.Nickname}
+	withdrawn.RecalculateStats()
+	if withdrawn.HP > withdrawn.Stats.HP {
+		withdrawn.HP = withdrawn.Stats.HP
+	}
+
+	if err := party.SetParty(s, append(mons, withdrawn)); err != nil {
+		return err
+	}
+	return s.SetByte(getLayout(s).inUse, 0)
+}
+
+// padName fits a raw name into its fixed-size field, padding with terminators
+func padName(name []byte, size int) []byte {
+	b := make([]byte, size)
+	for i := range b {
+		b[i] = 0x50
+	}
+	copy(b, name)
+	return b
+}
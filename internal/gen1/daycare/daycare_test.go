@@ -0,0 +1,160 @@
+package daycare
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/abravonunez/raracandy/internal/gen1/box"
+	"github.com/abravonunez/raracandy/internal/gen1/party"
+	"github.com/abravonunez/raracandy/internal/gen1/profile"
+	"github.com/abravonunez/raracandy/internal/gen1/save"
+	"github.com/abravonunez/raracandy/internal/gen1/species"
+)
+
+// newSave returns a test save whose party holds the given species at level 10,
+// each slightly hurt
+func newSave(t *testing.T, prof *profile.GameProfile, names ...string) *save.Save {
+	t.Helper()
+	s := save.CreateTestSaveFor(prof)
+
+	mons := make([]party.PartyMon, 0, len(names))
+	for i, name := range names {
+		index, err := species.GetIndex(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		mon := party.PartyMon{
+			Mon:      party.Mon{Species: index, BoxLevel: 10, Moves: [4]byte{0x21}, PP: [4]byte{35}, OTID: 4242, Exp: 1000},
+			Level:    10,
+			OTName:   []byte{0x80, 0x50},
+			Nickname: []byte{0x80 + byte(i), 0x81, 0x50},
+		}
+		mon.RecalculateStats()
+		mon.HP = mon.Stats.HP - 1
+		mons = append(mons, mon)
+	}
+	if err := party.SetParty(s, mons); err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func TestDepositAndWithdraw(t *testing.T) {
+	for _, prof := range []*profile.GameProfile{profile.ProfileYellowNA, profile.ProfileYellowJP} {
+		s := newSave(t, prof, "pikachu", "rattata", "pidgey")
+		before := party.GetParty(s)
+
+		if err := Deposit(s, 1); err != nil {
+			t.Fatalf("%s: Deposit() error: %v", prof.Name, err)
+		}
+		if !InUse(s) {
+			t.Fatalf("%s: daycare not in use after Deposit()", prof.Name)
+		}
+		mons := party.GetParty(s)
+		if len(mons) != 2 || mons[1].Species != before[2].Species {
+			t.Fatalf("%s: party after Deposit() = %v", prof.Name, mons)
+		}
+		if got := s.GetBytes(prof.OffsetParty+1, 3); got[0] != before[0].Species || got[1] != before[2].Species || got[2] != party.SpeciesListTerminator {
+			t.Errorf("%s: species list = % X", prof.Name, got)
+		}
+
+		mon, err := Get(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(mon.Mon.Encode(), before[1].Mon.Encode()) {
+			t.Errorf("%s: daycare struct differs from the deposited Pokémon", prof.Name)
+		}
+		if !bytes.Equal(mon.Nickname, before[1].Nickname) || !bytes.Equal(mon.OTName, before[1].OTName) {
+			t.Errorf("%s: names not kept: %q / %q", prof.Name, mon.Nickname, mon.OTName)
+		}
+
+		if err := Withdraw(s); err != nil {
+			t.Fatalf("%s: Withdraw() error: %v", prof.Name, err)
+		}
+		if InUse(s) {
+			t.Errorf("%s: daycare still in use after Withdraw()", prof.Name)
+		}
+		mons = party.GetParty(s)
+		if len(mons) != 3 {
+			t.Fatalf("%s: party has %d Pokémon after Withdraw()", prof.Name, len(mons))
+		}
+		got := mons[2]
+		if !bytes.Equal(got.Encode(), before[1].Encode()) || !bytes.Equal(got.Nickname, before[1].Nickname) || !bytes.Equal(got.OTName, before[1].OTName) {
+			t.Errorf("%s: withdrawn Pokémon differs from the deposited one", prof.Name)
+		}
+	}
+}
+
+func TestDepositRefused(t *testing.T) {
+	s := newSave(t, profile.ProfileYellowNA, "pikachu")
+	if err := Deposit(s, 0); err == nil {
+		t.Error("Deposit() should refuse the only Pokémon in the party")
+	}
+
+	s = newSave(t, profile.ProfileYellowNA, "pikachu", "rattata", "pidgey")
+	if err := Deposit(s, 5); err == nil {
+		t.Error("Deposit() should refuse an empty slot")
+	}
+	if err := Deposit(s, 0); err != nil {
+		t.Fatal(err)
+	}
+	if err := Deposit(s, 0); err == nil {
+		t.Error("Deposit() should refuse when the daycare is in use")
+	}
+}
+
+func TestWithdrawRefused(t *testing.T) {
+	s := newSave(t, profile.ProfileYellowNA, "pikachu")
+	if err := Withdraw(s); err == nil {
+		t.Error("Withdraw() should refuse an empty daycare")
+	}
+
+	s = newSave(t, profile.ProfileYellowNA, "pikachu", "rattata", "pidgey", "spearow", "ekans", "sandshrew")
+	mon := party.GetParty(s)[0]
+	if err := Set(s, box.BoxMon{Mon: mon.Mon, OTName: mon.OTName, Nickname: mon.Nickname}); err != nil {
+		t.Fatal(err)
+	}
+	if err := Withdraw(s); err == nil {
+		t.Error("Withdraw() should refuse a full party")
+	}
+}
+
+func TestWithdrawGrownLevel(t *testing.T) {
+	s := newSave(t, profile.ProfileYellowNA, "pikachu", "rattata")
+	if err := Deposit(s, 1); err != nil {
+		t.Fatal(err)
+	}
+
+	// Rattata grows Medium Fast: 3375 experience is level 15
+	mon, err := Get(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	mon.Exp = 3375
+	if err := Set(s, mon); err != nil {
+		t.Fatal(err)
+	}
+	if got := GrownLevel(mon); got != 15 {
+		t.Errorf("GrownLevel() = %d, want 15", got)
+	}
+
+	if err := Withdraw(s); err != nil {
+		t.Fatal(err)
+	}
+	got := party.GetParty(s)[1]
+	if got.Level != 15 || got.BoxLevel != 15 {
+		t.Errorf("withdrawn level = %d/%d, want 15/15", got.Level, got.BoxLevel)
+	}
+	want := got
+	want.RecalculateStats()
+	if got.Stats != want.Stats {
+		t.Errorf("stats not recalculated for level 15: %+v", got.Stats)
+	}
+
+	// Experience past the level 100 threshold stays at level 100
+	mon.Exp = 0xFFFFFF
+	if got := GrownLevel(mon); got != 100 {
+		t.Errorf("GrownLevel() = %d, want 100", got)
+	}
+}
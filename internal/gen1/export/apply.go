@@ -0,0 +1,431 @@
+package export
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/abravonunez/raracandy/internal/gen1/box"
+	"github.com/abravonunez/raracandy/internal/gen1/items"
+	"github.com/abravonunez/raracandy/internal/gen1/money"
+	"github.com/abravonunez/raracandy/internal/gen1/moves"
+	"github.com/abravonunez/raracandy/internal/gen1/options"
+	"github.com/abravonunez/raracandy/internal/gen1/party"
+	"github.com/abravonunez/raracandy/internal/gen1/pokedex"
+	"github.com/abravonunez/raracandy/internal/gen1/save"
+	"github.com/abravonunez/raracandy/internal/gen1/species"
+	"github.com/abravonunez/raracandy/internal/gen1/text"
+	"github.com/abravonunez/raracandy/internal/gen1/trainer"
+)
+
+// Changes lists what Apply would modify, trying the import on a copy of the
+// save so that invalid documents are rejected before anything is written
+func Changes(s *save.Save, doc *Document) ([]string, error) {
+	changes, _, err := applyChecked(s.Clone(), doc)
+	return changes, err
+}
+
+// Apply writes every section of doc that differs from the save and re-runs
+// the integrity check. Sections that match the save are left untouched, so
+// importing an unedited export changes nothing.
+func Apply(s *save.Save, doc *Document) (save.IntegrityReport, error) {
+	_, report, err := applyChecked(s, doc)
+	return report, err
+}
+
+func applyChecked(s *save.Save, doc *Document) ([]string, save.IntegrityReport, error) {
+	changes, err := apply(s, doc)
+	if err != nil {
+		return nil, save.IntegrityReport{}, err
+	}
+
+	s.RecalculateChecksum()
+	report := s.CheckIntegrity()
+	if !report.IsValid {
+		return nil, report, fmt.Errorf("imported save fails the integrity check: %s", strings.Join(report.Errors, "; "))
+	}
+	return changes, report, nil
+}
+
+func apply(s *save.Save, doc *Document) ([]string, error) {
+	if doc.SchemaVersion != SchemaVersion {
+		return nil, fmt.Errorf("unsupported export schema version %d (expected %d)", doc.SchemaVersion, SchemaVersion)
+	}
+
+	base, err := Export(s)
+	if err != nil {
+		return nil, err
+	}
+
+	var changes []string
+	record := func(format string, args ...any) {
+		changes = append(changes, fmt.Sprintf(format, args...))
+	}
+
+	if doc.Trainer.Player != base.Trainer.Player {
+		if err := trainer.SetPlayerName(s, doc.Trainer.Player); err != nil {
+			return nil, fmt.Errorf("player name: %w", err)
+		}
+		record("Player name: %q → %q", base.Trainer.Player, doc.Trainer.Player)
+	}
+	if doc.Trainer.Rival != base.Trainer.Rival {
+		if err := trainer.SetRivalName(s, doc.Trainer.Rival); err != nil {
+			return nil, fmt.Errorf("rival name: %w", err)
+		}
+		record("Rival name: %q → %q", base.Trainer.Rival, doc.Trainer.Rival)
+	}
+	if doc.Trainer.ID != base.Trainer.ID {
+		if err := trainer.SetID(s, doc.Trainer.ID); err != nil {
+			return nil, err
+		}
+		record("Trainer ID: %05d → %05d", base.Trainer.ID, doc.Trainer.ID)
+	}
+	if !sameList(doc.Trainer.Badges, base.Trainer.Badges) {
+		if err := setBadges(s, doc.Trainer.Badges); err != nil {
+			return nil, err
+		}
+		record("Badges: %d → %d", len(base.Trainer.Badges), len(doc.Trainer.Badges))
+	}
+	if doc.Trainer.PlayTime != base.Trainer.PlayTime {
+		if err := setPlayTime(s, doc.Trainer.PlayTime); err != nil {
+			return nil, err
+		}
+		record("Play time: %s → %s", base.Trainer.PlayTime.toTrainer(), doc.Trainer.PlayTime.toTrainer())
+	}
+
+	if doc.Money != base.Money {
+		if err := money.SetMoney(s, doc.Money); err != nil {
+			return nil, fmt.Errorf("money: %w", err)
+		}
+		record("Money: %s → %s", money.FormatMoney(base.Money), money.FormatMoney(doc.Money))
+	}
+	if doc.Coins != base.Coins {
+		if err := money.SetCoins(s, doc.Coins); err != nil {
+			return nil, fmt.Errorf("coins: %w", err)
+		}
+		record("Coins: %s → %s", money.FormatCoins(base.Coins), money.FormatCoins(doc.Coins))
+	}
+
+	if doc.Options != base.Options {
+		if err := setOptions(s, doc.Options); err != nil {
+			return nil, err
+		}
+		record("Options changed")
+	}
+
+	if !sameList(doc.Pokedex.Owned, base.Pokedex.Owned) {
+		owned, err := dexFlags(pokedex.GetOwned(s), doc.Pokedex.Owned)
+		if err != nil {
+			return nil, fmt.Errorf("pokedex owned: %w", err)
+		}
+		if err := pokedex.SetOwned(s, owned); err != nil {
+			return nil, err
+		}
+		record("Pokédex owned: %d → %d", len(base.Pokedex.Owned), len(doc.Pokedex.Owned))
+	}
+	if !sameList(doc.Pokedex.Seen, base.Pokedex.Seen) {
+		seen, err := dexFlags(pokedex.GetSeen(s), doc.Pokedex.Seen)
+		if err != nil {
+			return nil, fmt.Errorf("pokedex seen: %w", err)
+		}
+		if err := pokedex.SetSeen(s, seen); err != nil {
+			return nil, err
+		}
+		record("Pokédex seen: %d → %d", len(base.Pokedex.Seen), len(doc.Pokedex.Seen))
+	}
+
+	for _, list := range []struct {
+		list      items.ItemList
+		got, want []Item
+	}{
+		{items.Bag(s), base.Bag, doc.Bag},
+		{items.PCBox(s), base.PCItems, doc.PCItems},
+	} {
+		if sameList(list.got, list.want) {
+			continue
+		}
+		resolved, err := resolveItems(list.want)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", list.list.Name, err)
+		}
+		if err := items.SetItems(s, list.list, resolved); err != nil {
+			return nil, err
+		}
+		record("%s items: %d → %d entries", strings.ToUpper(list.list.Name[:1])+list.list.Name[1:], len(list.got), len(list.want))
+	}
+
+	partyChanges, err := applyParty(s, base.Party, doc.Party)
+	if err != nil {
+		return nil, err
+	}
+	changes = append(changes, partyChanges...)
+
+	boxChanges, err := applyBoxes(s, base.Boxes, doc.Boxes)
+	if err != nil {
+		return nil, err
+	}
+	changes = append(changes, boxChanges...)
+
+	return changes, nil
+}
+
+// applyParty rewrites only the slots that changed, or the whole party when
+// Pokémon were added or removed
+func applyParty(s *save.Save, base, want []Pokemon) ([]string, error) {
+	current := party.GetParty(s)
+	cs := s.GetProfile().Charset
+
+	resolved := make([]party.PartyMon, len(want))
+	var changed []int
+	for i, p := range want {
+		if i < len(base) && reflect.DeepEqual(p, base[i]) {
+			resolved[i] = current[i]
+			continue
+		}
+
+		var prev *Pokemon
+		var raw *party.PartyMon
+		if i < len(base) {
+			prev, raw = &base[i], &current[i]
+		}
+		mon, err := resolvePartyMon(p, prev, raw, cs)
+		if err != nil {
+			return nil, fmt.Errorf("party slot %d: %w", i+1, err)
+		}
+		resolved[i] = mon
+		changed = append(changed, i)
+	}
+
+	if len(want) != len(base) {
+		if err := party.SetParty(s, resolved); err != nil {
+			return nil, err
+		}
+		return []string{fmt.Sprintf("Party: %d → %d Pokémon", len(base), len(want))}, nil
+	}
+
+	changes := make([]string, 0, len(changed))
+	for _, slot := range changed {
+		if err := party.SetPartyMon(s, slot, resolved[slot]); err != nil {
+			return nil, err
+		}
+		changes = append(changes, fmt.Sprintf("Party slot %d: %s updated", slot+1, species.GetName(resolved[slot].Species)))
+	}
+	return changes, nil
+}
+
+// applyBoxes rewrites the boxes whose contents changed. Boxes missing from
+// the document are left alone.
+func applyBoxes(s *save.Save, base, want []Box) ([]string, error) {
+	prof := s.GetProfile()
+	var changes []string
+
+	for _, b := range want {
+		if b.Number < 1 || b.Number > prof.BoxCount {
+			return nil, fmt.Errorf("box %d out of range (1-%d)", b.Number, prof.BoxCount)
+		}
+		prevBox := base[b.Number-1]
+		if sameList(b.Pokemon, prevBox.Pokemon) {
+			continue
+		}
+
+		current, err := box.GetBox(s, b.Number-1)
+		if err != nil {
+			return nil, err
+		}
+		mons := make([]box.BoxMon, len(b.Pokemon))
+		for i, p := range b.Pokemon {
+			if i < len(prevBox.Pokemon) && reflect.DeepEqual(p, prevBox.Pokemon[i]) {
+				mons[i] = current[i]
+				continue
+			}
+
+			var prev *Pokemon
+			var prevOT, prevNick []byte
+			if i < len(prevBox.Pokemon) {
+				prev, prevOT, prevNick = &prevBox.Pokemon[i], current[i].OTName, current[i].Nickname
+			}
+			mon, ot, nick, err := resolveMon(p, prev, prevOT, prevNick, prof.Charset)
+			if err != nil {
+				return nil, fmt.Errorf("box %d slot %d: %w", b.Number, i+1, err)
+			}
+			mons[i] = box.BoxMon{Mon: mon, OTName: ot, Nickname: nick}
+		}
+
+		if err := box.SetBox(s, b.Number-1, mons); err != nil {
+			return nil, err
+		}
+		changes = append(changes, fmt.Sprintf("Box %d: %d → %d Pokémon", b.Number, len(prevBox.Pokemon), len(b.Pokemon)))
+	}
+	return changes, nil
+}
+
+// resolvePartyMon builds a party slot. Stats are recalculated when the
+// document omits them, or keeps the old ones while changing what they
+// derive from (species, level, DVs or stat experience).
+func resolvePartyMon(p Pokemon, prev *Pokemon, raw *party.PartyMon, cs text.Charset) (party.PartyMon, error) {
+	var prevOT, prevNick []byte
+	if raw != nil {
+		prevOT, prevNick = raw.OTName, raw.Nickname
+	}
+	mon, ot, nick, err := resolveMon(p, prev, prevOT, prevNick, cs)
+	if err != nil {
+		return party.PartyMon{}, err
+	}
+
+	pm := party.PartyMon{Mon: mon, Level: p.Level, OTName: ot, Nickname: nick}
+	pm.BoxLevel = p.Level
+	if p.BoxLevel != nil {
+		pm.BoxLevel = *p.BoxLevel
+	}
+
+	stale := prev != nil && prev.Stats != nil && p.Stats != nil && *p.Stats == *prev.Stats &&
+		(mon.Species != prev.SpeciesID || p.Level != prev.Level || p.DVs != prev.DVs || p.StatExp != prev.StatExp)
+	if p.Stats == nil || stale {
+		pm.RecalculateStats()
+	} else {
+		pm.Stats = party.Stats(*p.Stats)
+	}
+	return pm, nil
+}
+
+// resolveMon builds the shared structure and encodes the names. Names that
+// did not change keep their original bytes.
+func resolveMon(p Pokemon, prev *Pokemon, prevOT, prevNick []byte, cs text.Charset) (party.Mon, []byte, []byte, error) {
+	sp, err := resolveID(p.SpeciesID, p.Species, species.GetName, species.GetIndex)
+	if err != nil {
+		return party.Mon{}, nil, nil, err
+	}
+	if p.Level < 1 || p.Level > party.MaxLevel {
+		return party.Mon{}, nil, nil, fmt.Errorf("level %d out of range (1-%d)", p.Level, party.MaxLevel)
+	}
+	for _, dv := range []byte{p.DVs.Attack, p.DVs.Defense, p.DVs.Speed, p.DVs.Special} {
+		if dv > party.MaxDV {
+			return party.Mon{}, nil, nil, fmt.Errorf("DV %d out of range (0-%d)", dv, party.MaxDV)
+		}
+	}
+
+	mon := party.Mon{
+		Species:   sp,
+		HP:        p.HP,
+		BoxLevel:  p.Level,
+		Status:    p.Status,
+		Type1:     p.Type1,
+		Type2:     p.Type2,
+		CatchRate: p.CatchRate,
+		OTID:      p.OTID,
+		Exp:       p.Exp,
+		StatExp:   party.Stats(p.StatExp),
+		DVs:       party.DVs(p.DVs),
+	}
+	for i, m := range p.Moves {
+		id, err := resolveID(m.ID, m.Name, moves.GetMoveName, moveID)
+		if err != nil {
+			return party.Mon{}, nil, nil, err
+		}
+		mon.Moves[i] = id
+		mon.PP[i] = m.PP
+	}
+
+	ot := prevOT
+	if prev == nil || p.OTName != prev.OTName {
+		if ot, err = text.EncodeName(p.OTName, cs, text.PlayerNameLength(cs)); err != nil {
+			return party.Mon{}, nil, nil, fmt.Errorf("OT name: %w", err)
+		}
+	}
+	nick := prevNick
+	if prev == nil || p.Nickname != prev.Nickname {
+		if nick, err = text.EncodeName(p.Nickname, cs, text.NicknameLength(cs)); err != nil {
+			return party.Mon{}, nil, nil, fmt.Errorf("nickname: %w", err)
+		}
+	}
+
+	return mon, ot, nick, nil
+}
+
+// resolveID returns id, unless name was edited to something other than the
+// name of id, in which case the name is looked up instead
+func resolveID(id byte, name string, nameOf func(byte) string, lookup func(string) (byte, error)) (byte, error) {
+	if name == "" || name == nameOf(id) {
+		return id, nil
+	}
+	return lookup(name)
+}
+
+func moveID(name string) (byte, error) {
+	if name == moves.GetMoveName(moves.NoMove) {
+		return moves.NoMove, nil
+	}
+	return moves.GetMoveID(name)
+}
+
+func resolveItems(list []Item) ([]items.Item, error) {
+	out := make([]items.Item, 0, len(list))
+	for _, item := range list {
+		id, err := resolveID(item.ID, item.Name, items.GetItemName, items.GetItemID)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, items.Item{ID: id, Quantity: item.Quantity, Name: items.GetItemName(id)})
+	}
+	return out, nil
+}
+
+func setBadges(s *save.Save, names []string) error {
+	var badges trainer.Badges
+	for _, name := range names {
+		badge, err := trainer.ParseBadge(name)
+		if err != nil {
+			return err
+		}
+		badges = badges.With(badge, true)
+	}
+	return trainer.SetBadges(s, badges)
+}
+
+func setPlayTime(s *save.Save, t PlayTime) error {
+	if err := trainer.SetPlayTime(s, t.toTrainer()); err != nil {
+		return fmt.Errorf("play time: %w", err)
+	}
+	return nil
+}
+
+// setOptions writes the options byte as given: the menu's own values are
+// not enforced, so that any exported byte can be imported back
+func setOptions(s *save.Save, o Options) error {
+	if o.TextSpeed > 0x0F {
+		return fmt.Errorf("text speed %d out of range (0-15)", o.TextSpeed)
+	}
+	if o.Sound > byte(options.SoundEarphone3) {
+		return fmt.Errorf("sound %d out of range (0-3)", o.Sound)
+	}
+	style, err := options.ParseBattleStyle(o.BattleStyle)
+	if err != nil {
+		return err
+	}
+	decoded := options.Options{
+		TextSpeed:   options.TextSpeed(o.TextSpeed),
+		Animations:  o.BattleAnimations,
+		BattleStyle: style,
+		Sound:       options.Sound(o.Sound),
+	}
+	return s.SetByte(s.GetProfile().OffsetOptions, decoded.Encode())
+}
+
+// dexFlags replaces the flags of every dex number in b with the given
+// list, keeping the unused last bit as it was
+func dexFlags(b pokedex.Bitset, dex []int) (pokedex.Bitset, error) {
+	for _, n := range pokedex.All() {
+		b.Clear(n)
+	}
+	for _, n := range dex {
+		if err := b.Set(n); err != nil {
+			return b, err
+		}
+	}
+	return b, nil
+}
+
+// sameList compares two lists, treating nil (JSON null) and empty as equal
+func sameList[T any](a, b []T) bool {
+	return len(a) == len(b) && (len(a) == 0 || reflect.DeepEqual(a, b))
+}
@@ -0,0 +1,243 @@
+package export
+
+import (
+	"strings"
+
+	"github.com/abravonunez/raracandy/internal/gen1/box"
+	"github.com/abravonunez/raracandy/internal/gen1/items"
+	"github.com/abravonunez/raracandy/internal/gen1/money"
+	"github.com/abravonunez/raracandy/internal/gen1/moves"
+	"github.com/abravonunez/raracandy/internal/gen1/options"
+	"github.com/abravonunez/raracandy/internal/gen1/party"
+	"github.com/abravonunez/raracandy/internal/gen1/pokedex"
+	"github.com/abravonunez/raracandy/internal/gen1/save"
+	"github.com/abravonunez/raracandy/internal/gen1/species"
+	"github.com/abravonunez/raracandy/internal/gen1/text"
+	"github.com/abravonunez/raracandy/internal/gen1/trainer"
+)
+
+// SchemaVersion is bumped when fields are renamed or removed
+const SchemaVersion = 1
+
+// Document is every decoded field of a save. IDs are authoritative; the
+// names next to them are for reading, and are only looked up when they no
+// longer match the ID (so editing either one works).
+type Document struct {
+	SchemaVersion int       `json:"schema_version"`
+	Game          string    `json:"game"`
+	Trainer       Trainer   `json:"trainer"`
+	Money         uint32    `json:"money"`
+	Coins         uint32    `json:"coins"`
+	Options       Options   `json:"options"`
+	Pokedex       Pokedex   `json:"pokedex"`
+	Bag           []Item    `json:"bag"`
+	PCItems       []Item    `json:"pc_items"`
+	Party         []Pokemon `json:"party"`
+	Boxes         []Box     `json:"boxes"`
+}
+
+// Trainer is the trainer card: names, ID, badges and play time
+type Trainer struct {
+	Player   string   `json:"player"`
+	Rival    string   `json:"rival"`
+	ID       uint16   `json:"id"`
+	Badges   []string `json:"badges"`
+	PlayTime PlayTime `json:"play_time"`
+}
+
+// PlayTime is the in-game clock; Maxed is set once it stops at 255:59
+type PlayTime struct {
+	Hours   byte `json:"hours"`
+	Minutes byte `json:"minutes"`
+	Seconds byte `json:"seconds"`
+	Frames  byte `json:"frames"`
+	Maxed   bool `json:"maxed"`
+}
+
+// Options is the decoded options byte. Sound is only used by Yellow.
+type Options struct {
+	TextSpeed        byte   `json:"text_speed"` // frames per character: 1 fast, 3 medium, 5 slow
+	BattleAnimations bool   `json:"battle_animations"`
+	BattleStyle      string `json:"battle_style"` // shift or set
+	Sound            byte   `json:"sound"`
+}
+
+// Pokedex lists the National Dex numbers flagged as owned and seen
+type Pokedex struct {
+	Owned []int `json:"owned"`
+	Seen  []int `json:"seen"`
+}
+
+// Item is one bag or PC item entry
+type Item struct {
+	ID       byte   `json:"id"`
+	Name     string `json:"name"`
+	Quantity byte   `json:"quantity"`
+}
+
+// Pokemon is a party or box slot. Party-only fields are omitted for boxes.
+type Pokemon struct {
+	SpeciesID byte    `json:"species_id"`
+	Species   string  `json:"species"`
+	Nickname  string  `json:"nickname"`
+	OTName    string  `json:"ot_name"`
+	OTID      uint16  `json:"ot_id"`
+	Level     byte    `json:"level"`
+	BoxLevel  *byte   `json:"box_level,omitempty"` // party only, when it differs from level
+	Exp       uint32  `json:"exp"`
+	HP        uint16  `json:"hp"`
+	Status    byte    `json:"status"`
+	Type1     byte    `json:"type1"`
+	Type2     byte    `json:"type2"`
+	CatchRate byte    `json:"catch_rate"`
+	Moves     [4]Move `json:"moves"`
+	DVs       DVs     `json:"dvs"`
+	StatExp   Stats   `json:"stat_exp"`
+	Stats     *Stats  `json:"stats,omitempty"` // party only
+}
+
+// Move is one of a Pokémon's four move slots
+type Move struct {
+	ID   byte   `json:"id"`
+	Name string `json:"name"`
+	PP   byte   `json:"pp"` // low 6 bits are the current PP, high 2 bits the PP Ups
+}
+
+// DVs are the determinant values (0-15); HP is derived from the others
+type DVs struct {
+	Attack  byte `json:"attack"`
+	Defense byte `json:"defense"`
+	Speed   byte `json:"speed"`
+	Special byte `json:"special"`
+}
+
+// Stats holds one value per stat, used for stat experience and party stats
+type Stats struct {
+	HP      uint16 `json:"hp"`
+	Attack  uint16 `json:"attack"`
+	Defense uint16 `json:"defense"`
+	Speed   uint16 `json:"speed"`
+	Special uint16 `json:"special"`
+}
+
+// Box is one PC box and the Pokémon stored in it
+type Box struct {
+	Number  int       `json:"number"` // 1-based, as shown in-game
+	Pokemon []Pokemon `json:"pokemon"`
+}
+
+// Export decodes every supported field of a save
+func Export(s *save.Save) (*Document, error) {
+	prof := s.GetProfile()
+	doc := &Document{
+		SchemaVersion: SchemaVersion,
+		Game:          prof.Name,
+		Trainer: Trainer{
+			Player:   trainer.GetPlayerName(s),
+			Rival:    trainer.GetRivalName(s),
+			ID:       trainer.GetID(s),
+			Badges:   getBadges(s),
+			PlayTime: getPlayTime(s),
+		},
+		Money:   money.GetMoney(s),
+		Coins:   money.GetCoins(s),
+		Options: getOptions(s),
+		Pokedex: Pokedex{
+			Owned: pokedex.GetOwned(s).List(),
+			Seen:  pokedex.GetSeen(s).List(),
+		},
+		Bag:     exportItems(items.GetItems(s, items.Bag(s))),
+		PCItems: exportItems(items.GetItems(s, items.PCBox(s))),
+		Party:   make([]Pokemon, 0, prof.MaxPartyMons),
+		Boxes:   make([]Box, 0, prof.BoxCount),
+	}
+
+	for _, mon := range party.GetParty(s) {
+		doc.Party = append(doc.Party, exportPartyMon(mon, prof.Charset))
+	}
+
+	for b := 0; b < prof.BoxCount; b++ {
+		mons, err := box.GetBox(s, b)
+		if err != nil {
+			return nil, err
+		}
+		entry := Box{Number: b + 1, Pokemon: make([]Pokemon, 0, len(mons))}
+		for _, mon := range mons {
+			entry.Pokemon = append(entry.Pokemon, exportMon(mon.Mon, mon.OTName, mon.Nickname, prof.Charset))
+		}
+		doc.Boxes = append(doc.Boxes, entry)
+	}
+
+	return doc, nil
+}
+
+func exportItems(list []items.Item) []Item {
+	out := make([]Item, 0, len(list))
+	for _, item := range list {
+		out = append(out, Item{ID: item.ID, Name: item.Name, Quantity: item.Quantity})
+	}
+	return out
+}
+
+func exportMon(m party.Mon, otName, nickname []byte, cs text.Charset) Pokemon {
+	p := Pokemon{
+		SpeciesID: m.Species,
+		Species:   species.GetName(m.Species),
+		Nickname:  text.Decode(nickname, cs),
+		OTName:    text.Decode(otName, cs),
+		OTID:      m.OTID,
+		Level:     m.BoxLevel,
+		Exp:       m.Exp,
+		HP:        m.HP,
+		Status:    m.Status,
+		Type1:     m.Type1,
+		Type2:     m.Type2,
+		CatchRate: m.CatchRate,
+		DVs:       DVs(m.DVs),
+		StatExp:   Stats(m.StatExp),
+	}
+	for i, id := range m.Moves {
+		p.Moves[i] = Move{ID: id, Name: moves.GetMoveName(id), PP: m.PP[i]}
+	}
+	return p
+}
+
+func exportPartyMon(mon party.PartyMon, cs text.Charset) Pokemon {
+	p := exportMon(mon.Mon, mon.OTName, mon.Nickname, cs)
+	p.Level = mon.Level
+	if mon.BoxLevel != mon.Level {
+		boxLevel := mon.BoxLevel
+		p.BoxLevel = &boxLevel
+	}
+	stats := Stats(mon.Stats)
+	p.Stats = &stats
+	return p
+}
+
+func getBadges(s *save.Save) []string {
+	held := trainer.GetBadges(s).List()
+	badges := make([]string, 0, len(held))
+	for _, badge := range held {
+		badges = append(badges, strings.ToLower(badge.String()))
+	}
+	return badges
+}
+
+func getPlayTime(s *save.Save) PlayTime {
+	t := trainer.GetPlayTime(s)
+	return PlayTime{Hours: t.Hours, Minutes: t.Minutes, Seconds: t.Seconds, Frames: t.Frames, Maxed: t.Maxed}
+}
+
+func (t PlayTime) toTrainer() trainer.PlayTime {
+	return trainer.PlayTime{Hours: t.Hours, Minutes: t.Minutes, Seconds: t.Seconds, Frames: t.Frames, Maxed: t.Maxed}
+}
+
+func getOptions(s *save.Save) Options {
+	o := options.Get(s)
+	return Options{
+		TextSpeed:        byte(o.TextSpeed),
+		BattleAnimations: o.Animations,
+		BattleStyle:      o.BattleStyle.String(),
+		Sound:            byte(o.Sound),
+	}
+}
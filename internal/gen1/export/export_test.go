@@ -0,0 +1,223 @@
+package export
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/abravonunez/raracandy/internal/gen1/box"
+	"github.com/abravonunez/raracandy/internal/gen1/items"
+	"github.com/abravonunez/raracandy/internal/gen1/money"
+	"github.com/abravonunez/raracandy/internal/gen1/party"
+	"github.com/abravonunez/raracandy/internal/gen1/save"
+	"github.com/abravonunez/raracandy/internal/gen1/species"
+	"github.com/abravonunez/raracandy/internal/gen1/text"
+	"github.com/abravonunez/raracandy/internal/gen1/trainer"
+)
+
+func testSave(t *testing.T) *save.Save {
+	t.Helper()
+	s := save.CreateTestSave()
+	if err := trainer.SetPlayerName(s, "ASH"); err != nil {
+		t.Fatal(err)
+	}
+	if err := trainer.SetRivalName(s, "GARY"); err != nil {
+		t.Fatal(err)
+	}
+	if err := money.SetMoney(s, 3000); err != nil {
+		t.Fatal(err)
+	}
+	if err := items.SetItems(s, items.Bag(s), []items.Item{{ID: items.IDPotion, Quantity: 5}}); err != nil {
+		t.Fatal(err)
+	}
+
+	pikachu, _ := species.GetIndex("pikachu")
+	mon := party.PartyMon{
+		Mon:      party.Mon{Species: pikachu, BoxLevel: 5, Moves: [4]byte{0x54}, PP: [4]byte{30}, OTID: 12345},
+		Level:    5,
+		OTName:   mustEncode(t, "ASH"),
+		Nickname: mustEncode(t, "PIKACHU"),
+	}
+	mon.RecalculateStats()
+	mon.HP = mon.Stats.HP
+	if err := party.SetParty(s, []party.PartyMon{mon}); err != nil {
+		t.Fatal(err)
+	}
+	if err := box.SetBox(s, 0, []box.BoxMon{{Mon: mon.Mon, OTName: mon.OTName, Nickname: mon.Nickname}}); err != nil {
+		t.Fatal(err)
+	}
+
+	s.SetBytes(s.GetProfile().OffsetPokedexOwned, []byte{0x01})
+	s.SetBytes(s.GetProfile().OffsetPokedexSeen, []byte{0x05})
+	s.SetByte(s.GetProfile().OffsetBadges, 0x03)
+	s.SetBytes(s.GetProfile().OffsetPlayTime, []byte{12, 0, 34, 56, 7})
+	s.RecalculateChecksum()
+	return s
+}
+
+func mustEncode(t *testing.T, name string) []byte {
+	t.Helper()
+	b, err := text.Encode(name, text.English)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+// roundTrip exports the save through JSON, as the CLI does
+func roundTrip(t *testing.T, s *save.Save) *Document {
+	t.Helper()
+	doc, err := Export(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded Document
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	return &decoded
+}
+
+func TestExport(t *testing.T) {
+	doc, err := Export(testSave(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if doc.Trainer.Player != "ASH" || doc.Trainer.Rival != "GARY" {
+		t.Errorf("names = %q/%q", doc.Trainer.Player, doc.Trainer.Rival)
+	}
+	if len(doc.Trainer.Badges) != 2 || doc.Trainer.Badges[1] != "cascade" {
+		t.Errorf("badges = %v", doc.Trainer.Badges)
+	}
+	if doc.Trainer.PlayTime != (PlayTime{Hours: 12, Minutes: 34, Seconds: 56, Frames: 7}) {
+		t.Errorf("play time = %+v", doc.Trainer.PlayTime)
+	}
+	if len(doc.Pokedex.Owned) != 1 || len(doc.Pokedex.Seen) != 2 || doc.Pokedex.Seen[1] != 3 {
+		t.Errorf("pokedex = %+v", doc.Pokedex)
+	}
+	if len(doc.Party) != 1 || doc.Party[0].Species != "Pikachu" || doc.Party[0].Nickname != "PIKACHU" || doc.Party[0].Stats == nil {
+		t.Errorf("party = %+v", doc.Party)
+	}
+	if len(doc.Boxes) != 12 || len(doc.Boxes[0].Pokemon) != 1 || doc.Boxes[0].Pokemon[0].Stats != nil {
+		t.Errorf("boxes = %+v", doc.Boxes)
+	}
+}
+
+func TestRoundTripIsByteIdentical(t *testing.T) {
+	s := testSave(t)
+	// Bytes after a name terminator are not part of the decoded name and
+	// must survive an unedited import
+	s.SetByte(s.GetProfile().OffsetParty+1+7+6*party.PartyMonSize+6*11+8, 0x00)
+	s.RecalculateChecksum()
+	before := s.Data()
+
+	doc := roundTrip(t, s)
+	changes, err := Changes(s, doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(changes) != 0 {
+		t.Errorf("Changes() = %v, want none", changes)
+	}
+	if _, err := Apply(s, doc); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(before, s.Data()) {
+		t.Error("unedited import changed the save")
+	}
+}
+
+func TestApplyEdits(t *testing.T) {
+	s := testSave(t)
+	doc := roundTrip(t, s)
+
+	doc.Money = 999999
+	doc.Trainer.Badges = append(doc.Trainer.Badges, "earth")
+	doc.Pokedex.Owned = append(doc.Pokedex.Owned, 151)
+	doc.Bag = append(doc.Bag, Item{Name: "Rare Candy", Quantity: 99})
+	doc.Party[0].Level = 50
+	doc.Party[0].Nickname = "SPARKY"
+	doc.Party[0].Moves[1] = Move{Name: "Thunderbolt", PP: 15}
+	doc.Boxes[0].Pokemon = nil
+
+	changes, err := Changes(s, doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(changes) != 6 {
+		t.Errorf("Changes() = %v, want 6 entries", changes)
+	}
+	if _, err := Apply(s, doc); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := money.GetMoney(s); got != 999999 {
+		t.Errorf("money = %d", got)
+	}
+	if got := s.GetByte(s.GetProfile().OffsetBadges); got != 0x83 {
+		t.Errorf("badges = 0x%02X, want 0x83", got)
+	}
+	if got := s.GetByte(s.GetProfile().OffsetPokedexOwned + 18); got != 0x40 {
+		t.Errorf("owned flags byte 18 = 0x%02X, want 0x40", got)
+	}
+	if idx := items.FindItemIndex(s, items.IDRareCandy); idx != 1 {
+		t.Errorf("Rare Candy at index %d, want 1", idx)
+	}
+
+	mon, _ := party.GetPartyMon(s, 0)
+	if mon.Level != 50 || mon.BoxLevel != 50 {
+		t.Errorf("level = %d/%d, want 50", mon.Level, mon.BoxLevel)
+	}
+	if mon.Stats.HP <= 20 {
+		t.Errorf("stats not recalculated: %+v", mon.Stats)
+	}
+	if mon.Moves[1] != 0x55 {
+		t.Errorf("move 2 = 0x%02X, want Thunderbolt (0x55)", mon.Moves[1])
+	}
+	if got := text.Decode(mon.Nickname, text.English); got != "SPARKY" {
+		t.Errorf("nickname = %q", got)
+	}
+	if got := text.Decode(mon.OTName, text.English); got != "ASH" {
+		t.Errorf("OT name = %q", got)
+	}
+	if mons, _ := box.GetBox(s, 0); len(mons) != 0 {
+		t.Errorf("box 1 has %d Pokémon, want 0", len(mons))
+	}
+	if !s.ValidateChecksum() {
+		t.Error("checksum not updated")
+	}
+}
+
+func TestApplyRejectsInvalid(t *testing.T) {
+	tests := []struct {
+		name string
+		edit func(doc *Document)
+	}{
+		{"schema", func(doc *Document) { doc.SchemaVersion = 99 }},
+		{"species", func(doc *Document) { doc.Party[0].Species = "Agumon" }},
+		{"level", func(doc *Document) { doc.Party[0].Level = 101 }},
+		{"badge", func(doc *Document) { doc.Trainer.Badges = []string{"zephyr"} }},
+		{"dex", func(doc *Document) { doc.Pokedex.Seen = []int{152} }},
+		{"name", func(doc *Document) { doc.Trainer.Player = "TOOLONGNAME" }},
+		{"box", func(doc *Document) { doc.Boxes = append(doc.Boxes, Box{Number: 13}) }},
+	}
+
+	for _, tt := range tests {
+		s := testSave(t)
+		before := s.Data()
+		doc := roundTrip(t, s)
+		tt.edit(doc)
+
+		if _, err := Changes(s, doc); err == nil {
+			t.Errorf("%s: Changes() should fail", tt.name)
+		}
+		if !bytes.Equal(before, s.Data()) {
+			t.Errorf("%s: Changes() modified the save", tt.name)
+		}
+	}
+}
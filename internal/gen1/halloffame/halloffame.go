@@ -0,0 +1,84 @@
+package halloffame
+
+import (
+	"fmt"
+
+	"github.com/abravonunez/raracandy/internal/gen1/save"
+)
+
+const (
+	// MaxTeams is how many teams SRAM keeps. Once full, inducting a new
+	// team drops the oldest one.
+	MaxTeams = 50
+	// TeamSize is the number of Pokémon slots per team
+	TeamSize = 6
+
+	// monSize is one record: species, level, nickname and padding
+	monSize  = 16
+	teamSize = TeamSize * monSize
+
+	// listTerminator in the species byte ends a team of fewer than six
+	listTerminator = 0xFF
+)
+
+// Mon is one Pokémon of a Hall of Fame team, with its raw nickname
+// (0x50-terminated)
+type Mon struct {
+	Species  byte
+	Level    byte
+	Nickname []byte
+}
+
+// Team is one Hall of Fame entry. Number counts from the first team ever
+// inducted, so it keeps growing after the oldest records are dropped.
+type Team struct {
+	Number int
+	Mons   []Mon
+}
+
+// Inducted returns how many times the game has been beaten, which can be
+// more than the number of teams kept
+func Inducted(s *save.Save) int {
+	return int(s.GetByte(s.GetProfile().OffsetHallOfFameCount))
+}
+
+// Teams decodes the stored teams, oldest first
+func Teams(s *save.Save) []Team {
+	prof := s.GetProfile()
+	inducted := Inducted(s)
+	stored := inducted
+	if stored > MaxTeams {
+		stored = MaxTeams
+	}
+
+	teams := make([]Team, 0, stored)
+	for t := 0; t < stored; t++ {
+		team := Team{Number: inducted - stored + t + 1, Mons: make([]Mon, 0, TeamSize)}
+		for m := 0; m < TeamSize; m++ {
+			b := s.GetBytes(prof.OffsetHallOfFame+t*teamSize+m*monSize, monSize)
+			if len(b) != monSize || b[0] == listTerminator {
+				break
+			}
+			team.Mons = append(team.Mons, Mon{
+				Species:  b[0],
+				Level:    b[1],
+				Nickname: b[2 : 2+prof.NameLength],
+			})
+		}
+		teams = append(teams, team)
+	}
+	return teams
+}
+
+// Clear erases every team and resets the induction count. Bank 0 is not
+// checksummed; the count lives in the main data and is covered as usual.
+func Clear(s *save.Save) error {
+	prof := s.GetProfile()
+	if err := s.SetBytes(prof.OffsetHallOfFame, make([]byte, MaxTeams*teamSize)); err != nil {
+		return fmt.Errorf("failed to clear Hall of Fame teams: %w", err)
+	}
+	if err := s.SetByte(prof.OffsetHallOfFameCount, 0); err != nil {
+		return fmt.Errorf("failed to reset Hall of Fame count: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,69 @@
+package halloffame
+
+import (
+	"testing"
+
+	"github.com/abravonunez/raracandy/internal/gen1/save"
+)
+
+// writeTeam stores a team of Pokémon at the given levels in record t
+func writeTeam(s *save.Save, t int, levels ...byte) {
+	base := s.GetProfile().OffsetHallOfFame + t*teamSize
+	for m, level := range levels {
+		s.SetBytes(base+m*monSize, []byte{0x54, level, 0x8F, 0x88, 0x8A, 0x80, 0x50})
+	}
+	if len(levels) < TeamSize {
+		s.SetByte(base+len(levels)*monSize, listTerminator)
+	}
+}
+
+func TestTeams(t *testing.T) {
+	s := save.CreateTestSave()
+	if teams := Teams(s); len(teams) != 0 {
+		t.Fatalf("Teams() on a new save = %v", teams)
+	}
+
+	writeTeam(s, 0, 50, 51, 52, 53, 54, 55)
+	writeTeam(s, 1, 60)
+	s.SetByte(s.GetProfile().OffsetHallOfFameCount, 2)
+
+	teams := Teams(s)
+	if len(teams) != 2 || teams[0].Number != 1 || teams[1].Number != 2 {
+		t.Fatalf("Teams() = %+v", teams)
+	}
+	if len(teams[0].Mons) != 6 || teams[0].Mons[5].Level != 55 {
+		t.Errorf("team 1 = %+v", teams[0].Mons)
+	}
+	if len(teams[1].Mons) != 1 || teams[1].Mons[0].Species != 0x54 || teams[1].Mons[0].Nickname[0] != 0x8F {
+		t.Errorf("team 2 = %+v", teams[1].Mons)
+	}
+}
+
+func TestTeamsAfterOverflow(t *testing.T) {
+	s := save.CreateTestSave()
+	s.SetByte(s.GetProfile().OffsetHallOfFameCount, 60)
+
+	teams := Teams(s)
+	if len(teams) != MaxTeams {
+		t.Fatalf("Teams() returned %d teams, want %d", len(teams), MaxTeams)
+	}
+	if teams[0].Number != 11 || teams[MaxTeams-1].Number != 60 {
+		t.Errorf("team numbers = %d..%d, want 11..60", teams[0].Number, teams[MaxTeams-1].Number)
+	}
+}
+
+func TestClear(t *testing.T) {
+	s := save.CreateTestSave()
+	writeTeam(s, 0, 50)
+	s.SetByte(s.GetProfile().OffsetHallOfFameCount, 1)
+
+	if err := Clear(s); err != nil {
+		t.Fatal(err)
+	}
+	if Inducted(s) != 0 || len(Teams(s)) != 0 {
+		t.Error("Clear() left teams behind")
+	}
+	if got := s.GetByte(s.GetProfile().OffsetHallOfFame); got != 0 {
+		t.Errorf("first record byte = 0x%02X, want 0", got)
+	}
+}
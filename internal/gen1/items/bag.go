@@ -8,6 +8,7 @@ import (
 
 const (
 	MaxBagItems = 20
+	MaxPCItems  = 50
 	MaxItemQty  = 99
 )
 
@@ -18,18 +19,45 @@ type Item struct {
 	Name     string
 }
 
-// GetBagItems returns all items currently in the bag
-func GetBagItems(s *save.Save) []Item {
+// ItemList describes a count-prefixed, 0xFF-terminated list of
+// (ID, quantity) pairs such as the bag or the PC item box
+type ItemList struct {
+	Name        string
+	OffsetCount int
+	OffsetItems int
+	Capacity    int
+}
+
+// Bag returns the item list descriptor for the player's bag
+func Bag(s *save.Save) ItemList {
 	profile := s.GetProfile()
-	count := s.GetByte(profile.OffsetBagCount)
-	if count > MaxBagItems {
-		count = MaxBagItems
+	return ItemList{
+		Name:        "bag",
+		OffsetCount: profile.OffsetBagCount,
+		OffsetItems: profile.OffsetBagItems,
+		Capacity:    profile.MaxBagItems,
 	}
+}
+
+// PCBox returns the item list descriptor for the PC item storage
+func PCBox(s *save.Save) ItemList {
+	profile := s.GetProfile()
+	return ItemList{
+		Name:        "PC",
+		OffsetCount: profile.OffsetPCItemCount,
+		OffsetItems: profile.OffsetPCItems,
+		Capacity:    profile.MaxPCItems,
+	}
+}
+
+// GetItems returns all items currently in a list
+func GetItems(s *save.Save, list ItemList) []Item {
+	count := list.count(s)
 
 	items := make([]Item, 0, count)
-	offset := profile.OffsetBagItems
+	offset := list.OffsetItems
 
-	for i := byte(0); i < count; i++ {
+	for i := 0; i < count; i++ {
 		id := s.GetByte(offset)
 		qty := s.GetByte(offset + 1)
 
@@ -45,17 +73,16 @@ func GetBagItems(s *save.Save) []Item {
 	return items
 }
 
-// FindItemIndex finds the index of an item in the bag by ID
+// FindIndex finds the index of an item in a list by ID
 // Returns -1 if not found
-func FindItemIndex(s *save.Save, itemID byte) int {
-	profile := s.GetProfile()
-	count := s.GetByte(profile.OffsetBagCount)
-	offset := profile.OffsetBagItems
+func FindIndex(s *save.Save, list ItemList, itemID byte) int {
+	count := list.count(s)
+	offset := list.OffsetItems
 
-	for i := byte(0); i < count; i++ {
+	for i := 0; i < count; i++ {
 		id := s.GetByte(offset)
 		if id == itemID {
-			return int(i)
+			return i
 		}
 		offset += 2
 	}
@@ -63,42 +90,52 @@ func FindItemIndex(s *save.Save, itemID byte) int {
 	return -1
 }
 
-// SetItemQuantity updates the quantity of an existing item in the bag
-// If the item doesn't exist, it will be added to the bag
-func SetItemQuantity(s *save.Save, itemID byte, quantity byte) error {
+// SetQuantity updates the quantity of an existing item in a list
+// If the item doesn't exist, it will be added to the list
+func SetQuantity(s *save.Save, list ItemList, itemID byte, quantity byte) error {
+	if err := ValidID(itemID); err != nil {
+		return err
+	}
 	if quantity > MaxItemQty {
 		return fmt.Errorf("quantity %d exceeds maximum %d", quantity, MaxItemQty)
 	}
+	if _, err := CheckQuantity(itemID, quantity); err != nil {
+		return err
+	}
 
 	// Check if item exists
-	idx := FindItemIndex(s, itemID)
+	idx := FindIndex(s, list, itemID)
 
 	if idx >= 0 {
 		// Item exists, update quantity
-		profile := s.GetProfile()
-		offset := profile.OffsetBagItems + (idx * 2) + 1
+		offset := list.OffsetItems + (idx * 2) + 1
 		return s.SetByte(offset, quantity)
 	}
 
 	// Item doesn't exist, add it
-	return AddItem(s, itemID, quantity)
+	return Add(s, list, itemID, quantity)
 }
 
-// AddItem adds a new item to the bag
-func AddItem(s *save.Save, itemID byte, quantity byte) error {
+// Add appends a new item to a list
+func Add(s *save.Save, list ItemList, itemID byte, quantity byte) error {
+	if err := ValidID(itemID); err != nil {
+		return err
+	}
 	if quantity > MaxItemQty {
 		return fmt.Errorf("quantity %d exceeds maximum %d", quantity, MaxItemQty)
 	}
+	if _, err := CheckQuantity(itemID, quantity); err != nil {
+		return err
+	}
 
-	profile := s.GetProfile()
-	count := s.GetByte(profile.OffsetBagCount)
+	count := s.GetByte(list.OffsetCount)
 
-	if count >= MaxBagItems {
-		return fmt.Errorf("bag is full (max %d items)", MaxBagItems)
+	if int(count) >= list.Capacity {
+		return fmt.Errorf("%s is full (max %d items)", list.Name, list.Capacity)
 	}
 
 	// Calculate offset for new item (after last item)
-	offset := profile.OffsetBagItems + (int(count) * 2)
+	offset := list.OffsetItems + (int(count) * 2)
 
 	// Set item ID and quantity
 	if err := s.SetByte(offset, itemID); err != nil {
@@ -108,9 +145,9 @@ func AddItem(s *save.Save, itemID byte, quantity byte) error {
 		return fmt.Errorf("failed to set item quantity: %w", err)
 	}
 
-	// Increment bag count
-	if err := s.SetByte(profile.OffsetBagCount, count+1); err != nil {
-		return fmt.Errorf("failed to update bag count: %w", err)
+	// Increment count
+	if err := s.SetByte(list.OffsetCount, count+1); err != nil {
+		return fmt.Errorf("failed to update %s count: %w", list.Name, err)
 	}
 
 	// Add terminator byte (0xFF) after the new item
@@ -121,20 +158,19 @@ func AddItem(s *save.Save, itemID byte, quantity byte) error {
 	return nil
 }
 
-// RemoveItem removes an item from the bag by ID
-func RemoveItem(s *save.Save, itemID byte) error {
-	idx := FindItemIndex(s, itemID)
+// Remove removes an item from a list by ID
+func Remove(s *save.Save, list ItemList, itemID byte) error {
+	idx := FindIndex(s, list, itemID)
 	if idx < 0 {
-		return fmt.Errorf("item not found in bag")
+		return fmt.Errorf("item not found in %s", list.Name)
 	}
 
-	profile := s.GetProfile()
-	count := s.GetByte(profile.OffsetBagCount)
+	count := s.GetByte(list.OffsetCount)
 
 	// Shift all items after the removed one
 	for i := idx; i < int(count)-1; i++ {
-		srcOffset := profile.OffsetBagItems + ((i + 1) * 2)
-		dstOffset := profile.OffsetBagItems + (i * 2)
+		srcOffset := list.OffsetItems + ((i + 1) * 2)
+		dstOffset := list.OffsetItems + (i * 2)
 
 		id := s.GetByte(srcOffset)
 		qty := s.GetByte(srcOffset + 1)
@@ -144,11 +180,122 @@ func RemoveItem(s *save.Save, itemID byte) error {
 	}
 
 	// Decrement count
-	s.SetByte(profile.OffsetBagCount, count-1)
+	s.SetByte(list.OffsetCount, count-1)
 
 	// Add terminator at new end
-	terminatorOffset := profile.OffsetBagItems + (int(count-1) * 2)
+	terminatorOffset := list.OffsetItems + (int(count-1) * 2)
 	s.SetByte(terminatorOffset, 0xFF)
 
 	return nil
 }
+
+// Toss decreases the quantity of an item in a list, removing it when none are left
+func Toss(s *save.Save, list ItemList, itemID byte, quantity byte) error {
+	idx := FindIndex(s, list, itemID)
+	if idx < 0 {
+		return fmt.Errorf("item not found in %s", list.Name)
+	}
+
+	current := GetItems(s, list)[idx].Quantity
+	if quantity > current {
+		return fmt.Errorf("cannot remove %d %s, only %d in %s", quantity, GetItemName(itemID), current, list.Name)
+	}
+	if quantity == current {
+		return Remove(s, list, itemID)
+	}
+	return s.SetByte(list.OffsetItems+(idx*2)+1, current-quantity)
+}
+
+// Move transfers a quantity of an item from one list to another, merging
+// with an existing stack in the destination
+func Move(s *save.Save, from, to ItemList, itemID byte, quantity byte) error {
+	idx := FindIndex(s, from, itemID)
+	if idx < 0 {
+		return fmt.Errorf("item not found in %s", from.Name)
+	}
+	if available := GetItems(s, from)[idx].Quantity; quantity > available {
+		return fmt.Errorf("cannot move %d %s, only %d in %s", quantity, GetItemName(itemID), available, from.Name)
+	}
+
+	var existing byte
+	if dst := FindIndex(s, to, itemID); dst >= 0 {
+		existing = GetItems(s, to)[dst].Quantity
+	}
+	if int(existing)+int(quantity) > MaxItemQty {
+		return fmt.Errorf("%s would hold %d %s, maximum is %d", to.Name, int(existing)+int(quantity), GetItemName(itemID), MaxItemQty)
+	}
+
+	if err := SetQuantity(s, to, itemID, existing+quantity); err != nil {
+		return err
+	}
+	return Toss(s, from, itemID, quantity)
+}
+
+// SetItems replaces the whole contents of a list, in order, and writes the
+// terminator after the last entry
+func SetItems(s *save.Save, list ItemList, items []Item) error {
+	if len(items) > list.Capacity {
+		return fmt.Errorf("%s can hold at most %d items, got %d", list.Name, list.Capacity, len(items))
+	}
+	for _, item := range items {
+		if err := ValidID(item.ID); err != nil {
+			return err
+		}
+		if item.Quantity > MaxItemQty {
+			return fmt.Errorf("%s: quantity %d exceeds maximum %d", GetItemName(item.ID), item.Quantity, MaxItemQty)
+		}
+		if _, err := CheckQuantity(item.ID, item.Quantity); err != nil {
+			return err
+		}
+	}
+
+	data := make([]byte, 0, len(items)*2+1)
+	for _, item := range items {
+		data = append(data, item.ID, item.Quantity)
+	}
+	data = append(data, 0xFF)
+
+	if err := s.SetBytes(list.OffsetItems, data); err != nil {
+		return fmt.Errorf("failed to write %s items: %w", list.Name, err)
+	}
+	if err := s.SetByte(list.OffsetCount, byte(len(items))); err != nil {
+		return fmt.Errorf("failed to update %s count: %w", list.Name, err)
+	}
+	return nil
+}
+
+// count returns the stored item count, capped at the list capacity
+func (list ItemList) count(s *save.Save) int {
+	count := int(s.GetByte(list.OffsetCount))
+	if count > list.Capacity {
+		count = list.Capacity
+	}
+	return count
+}
+
+// GetBagItems returns all items currently in the bag
+func GetBagItems(s *save.Save) []Item {
+	return GetItems(s, Bag(s))
+}
+
+// FindItemIndex finds the index of an item in the bag by ID
+// Returns -1 if not found
+func FindItemIndex(s *save.Save, itemID byte) int {
+	return FindIndex(s, Bag(s), itemID)
+}
+
+// SetItemQuantity updates the quantity of an existing item in the bag
+// If the item doesn't exist, it will be added to the bag
+func SetItemQuantity(s *save.Save, itemID byte, quantity byte) error {
+	return SetQuantity(s, Bag(s), itemID, quantity)
+}
+
+// AddItem adds a new item to the bag
+func AddItem(s *save.Save, itemID byte, quantity byte) error {
+	return Add(s, Bag(s), itemID, quantity)
+}
+
+// RemoveItem removes an item from the bag by ID
+func RemoveItem(s *save.Save, itemID byte) error {
+	return Remove(s, Bag(s), itemID)
+}
@@ -0,0 +1,67 @@
+package items
+
+import (
+	"testing"
+
+	"github.com/abravonunez/raracandy/internal/gen1/save"
+)
+
+func TestPCBoxCapacity(t *testing.T) {
+	s := save.CreateTestSave()
+	pc := PCBox(s)
+
+	for i := 0; i < MaxPCItems; i++ {
+		if err := Add(s, pc, byte(i+1), 1); err != nil {
+			t.Fatalf("Add() item %d error = %v", i+1, err)
+		}
+	}
+	if err := Add(s, pc, 0x60, 1); err == nil {
+		t.Error("Add() on a full PC should fail")
+	}
+
+	// The bag is untouched and still limited to 20 items
+	if got := len(GetBagItems(s)); got != 0 {
+		t.Errorf("bag has %d items, want 0", got)
+	}
+	if got := len(GetItems(s, pc)); got != MaxPCItems {
+		t.Errorf("PC has %d items, want %d", got, MaxPCItems)
+	}
+}
+
+func TestMoveToBag(t *testing.T) {
+	s := save.CreateTestSave()
+	pc, bag := PCBox(s), Bag(s)
+
+	Add(s, pc, IDRareCandy, 60)
+	Add(s, pc, IDPotion, 5)
+	Add(s, bag, IDRareCandy, 50)
+
+	if err := Move(s, pc, bag, IDRareCandy, 61); err == nil {
+		t.Error("Move() of more than the PC holds should fail")
+	}
+	if err := Move(s, pc, bag, IDRareCandy, 50); err == nil {
+		t.Error("Move() exceeding 99 in the bag should fail")
+	}
+
+	if err := Move(s, pc, bag, IDRareCandy, 40); err != nil {
+		t.Fatalf("Move() error = %v", err)
+	}
+	if idx := FindItemIndex(s, IDRareCandy); GetBagItems(s)[idx].Quantity != 90 {
+		t.Errorf("bag Rare Candy = %d, want 90", GetBagItems(s)[idx].Quantity)
+	}
+	if idx := FindIndex(s, pc, IDRareCandy); GetItems(s, pc)[idx].Quantity != 20 {
+		t.Errorf("PC Rare Candy = %d, want 20", GetItems(s, pc)[idx].Quantity)
+	}
+
+	// Moving a whole stack removes it from the PC and keeps the list packed
+	if err := Move(s, pc, bag, IDPotion, 5); err != nil {
+		t.Fatalf("Move() error = %v", err)
+	}
+	pcItems := GetItems(s, pc)
+	if len(pcItems) != 1 || pcItems[0].ID != IDRareCandy {
+		t.Errorf("PC items = %+v, want only Rare Candy", pcItems)
+	}
+	if s.GetByte(pc.OffsetItems+2) != 0xFF {
+		t.Error("missing PC terminator after removal")
+	}
+}
@@ -2,125 +2,375 @@ package items
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
+
+	"github.com/abravonunez/raracandy/internal/gen1/moves"
 )
 
-// Item ID constants for Pokemon Yellow
+// Category groups items by how the game treats them
+type Category int
+
 const (
-	IDRareCandy     = 0x28
-	IDMasterBall    = 0x01
-	IDUltraBall     = 0x02
-	IDGreatBall     = 0x03
-	IDPokeBall      = 0x04
-	IDPotion        = 0x14
-	IDSuperPotion   = 0x15
-	IDHyperPotion   = 0x16
-	IDMaxPotion     = 0x17
-	IDFullRestore   = 0x18
-	IDRevive        = 0x19
-	IDMaxRevive     = 0x1A
-	IDEscape        = 0x1C
-	IDRepel         = 0x1D
-	IDSuperRepel    = 0x1E
-	IDMaxRepel      = 0x1F
-	IDAntidote      = 0x20
-	IDBurnHeal      = 0x21
-	IDIceHeal       = 0x22
-	IDAwakening     = 0x23
-	IDParalyzeHeal  = 0x24
-	IDFullHeal      = 0x25
+	CategoryGlitch Category = iota
+	CategoryBall
+	CategoryMedicine
+	CategoryBattle
+	CategoryGeneral
+	CategoryKey
+	CategoryTMHM
 )
 
-// itemNames maps item IDs to human-readable names
-var itemNames = map[byte]string{
-	IDRareCandy:     "Rare Candy",
-	IDMasterBall:    "Master Ball",
-	IDUltraBall:     "Ultra Ball",
-	IDGreatBall:     "Great Ball",
-	IDPokeBall:      "Poké Ball",
-	IDPotion:        "Potion",
-	IDSuperPotion:   "Super Potion",
-	IDHyperPotion:   "Hyper Potion",
-	IDMaxPotion:     "Max Potion",
-	IDFullRestore:   "Full Restore",
-	IDRevive:        "Revive",
-	IDMaxRevive:     "Max Revive",
-	IDEscape:        "Escape Rope",
-	IDRepel:         "Repel",
-	IDSuperRepel:    "Super Repel",
-	IDMaxRepel:      "Max Repel",
-	IDAntidote:      "Antidote",
-	IDBurnHeal:      "Burn Heal",
-	IDIceHeal:       "Ice Heal",
-	IDAwakening:     "Awakening",
-	IDParalyzeHeal:  "Paralyze Heal",
-	IDFullHeal:      "Full Heal",
+func (c Category) String() string {
+	switch c {
+	case CategoryBall:
+		return "ball"
+	case CategoryMedicine:
+		return "medicine"
+	case CategoryBattle:
+		return "battle"
+	case CategoryGeneral:
+		return "general"
+	case CategoryKey:
+		return "key"
+	case CategoryTMHM:
+		return "TM/HM"
+	default:
+		return "glitch"
+	}
+}
+
+// ItemInfo describes an item ID: its name, category and bag rules
+type ItemInfo struct {
+	ID        byte
+	Name      string
+	Category  Category
+	Stackable bool // can be held in quantities above 1
+	Tossable  bool // can be tossed from the bag
+}
+
+// Item ID constants for Pokemon Red/Blue/Yellow
+const (
+	IDMasterBall   = 0x01
+	IDUltraBall    = 0x02
+	IDGreatBall    = 0x03
+	IDPokeBall     = 0x04
+	IDTownMap      = 0x05
+	IDBicycle      = 0x06
+	IDSurfboard    = 0x07
+	IDSafariBall   = 0x08
+	IDPokedex      = 0x09
+	IDMoonStone    = 0x0A
+	IDAntidote     = 0x0B
+	IDBurnHeal     = 0x0C
+	IDIceHeal      = 0x0D
+	IDAwakening    = 0x0E
+	IDParalyzeHeal = 0x0F
+	IDFullRestore  = 0x10
+	IDMaxPotion    = 0x11
+	IDHyperPotion  = 0x12
+	IDSuperPotion  = 0x13
+	IDPotion       = 0x14
+	IDBoulderBadge = 0x15
+	IDCascadeBadge = 0x16
+	IDThunderBadge = 0x17
+	IDRainbowBadge = 0x18
+	IDSoulBadge    = 0x19
+	IDMarshBadge   = 0x1A
+	IDVolcanoBadge = 0x1B
+	IDEarthBadge   = 0x1C
+	IDEscape       = 0x1D
+	IDRepel        = 0x1E
+	IDOldAmber     = 0x1F
+	IDFireStone    = 0x20
+	IDThunderStone = 0x21
+	IDWaterStone   = 0x22
+	IDHPUp         = 0x23
+	IDProtein      = 0x24
+	IDIron         = 0x25
+	IDCarbos       = 0x26
+	IDCalcium      = 0x27
+	IDRareCandy    = 0x28
+	IDDomeFossil   = 0x29
+	IDHelixFossil  = 0x2A
+	IDSecretKey    = 0x2B
+	IDBikeVoucher  = 0x2D
+	IDXAccuracy    = 0x2E
+	IDLeafStone    = 0x2F
+	IDCardKey      = 0x30
+	IDNugget       = 0x31
+	IDPokeDoll     = 0x33
+	IDFullHeal     = 0x34
+	IDRevive       = 0x35
+	IDMaxRevive    = 0x36
+	IDGuardSpec    = 0x37
+	IDSuperRepel   = 0x38
+	IDMaxRepel     = 0x39
+	IDDireHit      = 0x3A
+	IDFreshWater   = 0x3C
+	IDSodaPop      = 0x3D
+	IDLemonade     = 0x3E
+	IDSSTicket     = 0x3F
+	IDGoldTeeth    = 0x40
+	IDXAttack      = 0x41
+	IDXDefend      = 0x42
+	IDXSpeed       = 0x43
+	IDXSpecial     = 0x44
+	IDCoinCase     = 0x45
+	IDOaksParcel   = 0x46
+	IDItemfinder   = 0x47
+	IDSilphScope   = 0x48
+	IDPokeFlute    = 0x49
+	IDLiftKey      = 0x4A
+	IDExpAll       = 0x4B
+	IDOldRod       = 0x4C
+	IDGoodRod      = 0x4D
+	IDSuperRod     = 0x4E
+	IDPPUp         = 0x4F
+	IDEther        = 0x50
+	IDMaxEther     = 0x51
+	IDElixir       = 0x52
+	IDMaxElixir    = 0x53
+	IDHM01         = 0xC4
+	IDTM01         = 0xC9
+	IDTM50         = 0xFA
+	NumHMs         = 5
+	NumTMs         = 50
+)
+
+// namedItems lists every regular item. IDs missing here (and not TMs/HMs)
+// are glitch items.
+var namedItems = []struct {
+	id       byte
+	name     string
+	category Category
+}{
+	{IDMasterBall, "Master Ball", CategoryBall},
+	{IDUltraBall, "Ultra Ball", CategoryBall},
+	{IDGreatBall, "Great Ball", CategoryBall},
+	{IDPokeBall, "Poké Ball", CategoryBall},
+	{IDTownMap, "Town Map", CategoryKey},
+	{IDBicycle, "Bicycle", CategoryKey},
+	{IDSurfboard, "Surfboard", CategoryKey},
+	{IDSafariBall, "Safari Ball", CategoryBall},
+	{IDPokedex, "Pokédex", CategoryKey},
+	{IDMoonStone, "Moon Stone", CategoryGeneral},
+	{IDAntidote, "Antidote", CategoryMedicine},
+	{IDBurnHeal, "Burn Heal", CategoryMedicine},
+	{IDIceHeal, "Ice Heal", CategoryMedicine},
+	{IDAwakening, "Awakening", CategoryMedicine},
+	{IDParalyzeHeal, "Paralyze Heal", CategoryMedicine},
+	{IDFullRestore, "Full Restore", CategoryMedicine},
+	{IDMaxPotion, "Max Potion", CategoryMedicine},
+	{IDHyperPotion, "Hyper Potion", CategoryMedicine},
+	{IDSuperPotion, "Super Potion", CategoryMedicine},
+	{IDPotion, "Potion", CategoryMedicine},
+	{IDBoulderBadge, "Boulder Badge", CategoryKey},
+	{IDCascadeBadge, "Cascade Badge", CategoryKey},
+	{IDThunderBadge, "Thunder Badge", CategoryKey},
+	{IDRainbowBadge, "Rainbow Badge", CategoryKey},
+	{IDSoulBadge, "Soul Badge", CategoryKey},
+	{IDMarshBadge, "Marsh Badge", CategoryKey},
+	{IDVolcanoBadge, "Volcano Badge", CategoryKey},
+	{IDEarthBadge, "Earth Badge", CategoryKey},
+	{IDEscape, "Escape Rope", CategoryGeneral},
+	{IDRepel, "Repel", CategoryGeneral},
+	{IDOldAmber, "Old Amber", CategoryKey},
+	{IDFireStone, "Fire Stone", CategoryGeneral},
+	{IDThunderStone, "Thunder Stone", CategoryGeneral},
+	{IDWaterStone, "Water Stone", CategoryGeneral},
+	{IDHPUp, "HP Up", CategoryMedicine},
+	{IDProtein, "Protein", CategoryMedicine},
+	{IDIron, "Iron", CategoryMedicine},
+	{IDCarbos, "Carbos", CategoryMedicine},
+	{IDCalcium, "Calcium", CategoryMedicine},
+	{IDRareCandy, "Rare Candy", CategoryMedicine},
+	{IDDomeFossil, "Dome Fossil", CategoryKey},
+	{IDHelixFossil, "Helix Fossil", CategoryKey},
+	{IDSecretKey, "Secret Key", CategoryKey},
+	{IDBikeVoucher, "Bike Voucher", CategoryKey},
+	{IDXAccuracy, "X Accuracy", CategoryBattle},
+	{IDLeafStone, "Leaf Stone", CategoryGeneral},
+	{IDCardKey, "Card Key", CategoryKey},
+	{IDNugget, "Nugget", CategoryGeneral},
+	{IDPokeDoll, "Poké Doll", CategoryBattle},
+	{IDFullHeal, "Full Heal", CategoryMedicine},
+	{IDRevive, "Revive", CategoryMedicine},
+	{IDMaxRevive, "Max Revive", CategoryMedicine},
+	{IDGuardSpec, "Guard Spec.", CategoryBattle},
+	{IDSuperRepel, "Super Repel", CategoryGeneral},
+	{IDMaxRepel, "Max Repel", CategoryGeneral},
+	{IDDireHit, "Dire Hit", CategoryBattle},
+	{IDFreshWater, "Fresh Water", CategoryMedicine},
+	{IDSodaPop, "Soda Pop", CategoryMedicine},
+	{IDLemonade, "Lemonade", CategoryMedicine},
+	{IDSSTicket, "S.S. Ticket", CategoryKey},
+	{IDGoldTeeth, "Gold Teeth", CategoryKey},
+	{IDXAttack, "X Attack", CategoryBattle},
+	{IDXDefend, "X Defend", CategoryBattle},
+	{IDXSpeed, "X Speed", CategoryBattle},
+	{IDXSpecial, "X Special", CategoryBattle},
+	{IDCoinCase, "Coin Case", CategoryKey},
+	{IDOaksParcel, "Oak's Parcel", CategoryKey},
+	{IDItemfinder, "Itemfinder", CategoryKey},
+	{IDSilphScope, "Silph Scope", CategoryKey},
+	{IDPokeFlute, "Poké Flute", CategoryKey},
+	{IDLiftKey, "Lift Key", CategoryKey},
+	{IDExpAll, "Exp. All", CategoryKey},
+	{IDOldRod, "Old Rod", CategoryKey},
+	{IDGoodRod, "Good Rod", CategoryKey},
+	{IDSuperRod, "Super Rod", CategoryKey},
+	{IDPPUp, "PP Up", CategoryMedicine},
+	{IDEther, "Ether", CategoryMedicine},
+	{IDMaxEther, "Max Ether", CategoryMedicine},
+	{IDElixir, "Elixir", CategoryMedicine},
+	{IDMaxElixir, "Max Elixir", CategoryMedicine},
+}
+
+// hmMoves and tmMoves list the move taught by each HM/TM, in order
+var hmMoves = [NumHMs]byte{0x0F, 0x13, 0x39, 0x46, 0x94}
+
+var tmMoves = [NumTMs]byte{
+	0x05, 0x0D, 0x0E, 0x12, 0x19, 0x5C, 0x20, 0x22, 0x24, 0x26,
+	0x3D, 0x37, 0x3A, 0x3B, 0x3F, 0x06, 0x42, 0x44, 0x45, 0x63,
+	0x48, 0x4C, 0x52, 0x55, 0x57, 0x59, 0x5A, 0x5B, 0x5E, 0x64,
+	0x66, 0x68, 0x73, 0x75, 0x76, 0x78, 0x79, 0x7E, 0x81, 0x82,
+	0x87, 0x8A, 0x8F, 0x9C, 0x56, 0x95, 0x99, 0x9D, 0xA1, 0xA4,
+}
+
+// itemTable holds an entry for every possible item ID, built at init
+var itemTable [256]ItemInfo
+
+// itemIDs maps normalized names to item IDs (lowercase for case-insensitive lookup)
+var itemIDs = make(map[string]byte)
+
+func init() {
+	for i := range itemTable {
+		itemTable[i] = ItemInfo{
+			ID:        byte(i),
+			Name:      fmt.Sprintf("Glitch Item (0x%02X)", i),
+			Category:  CategoryGlitch,
+			Stackable: true,
+			Tossable:  true,
+		}
+	}
+
+	for _, it := range namedItems {
+		key := it.category == CategoryKey
+		itemTable[it.id] = ItemInfo{
+			ID:        it.id,
+			Name:      it.name,
+			Category:  it.category,
+			Stackable: !key,
+			Tossable:  !key,
+		}
+		registerName(it.name, it.id)
+	}
+
+	for i, move := range hmMoves {
+		id := byte(IDHM01 + i)
+		itemTable[id] = ItemInfo{
+			ID:       id,
+			Name:     fmt.Sprintf("HM%02d (%s)", i+1, moves.GetMoveName(move)),
+			Category: CategoryTMHM,
+		}
+		registerName(fmt.Sprintf("HM%02d", i+1), id)
+	}
+
+	for i, move := range tmMoves {
+		id := byte(IDTM01 + i)
+		itemTable[id] = ItemInfo{
+			ID:        id,
+			Name:      fmt.Sprintf("TM%02d (%s)", i+1, moves.GetMoveName(move)),
+			Category:  CategoryTMHM,
+			Stackable: true,
+			Tossable:  true,
+		}
+		registerName(fmt.Sprintf("TM%02d", i+1), id)
+	}
 }
 
-// itemIDs maps human-readable names to item IDs (lowercase for case-insensitive lookup)
-var itemIDs = map[string]byte{
-	"rare_candy":    IDRareCandy,
-	"rarecandy":     IDRareCandy,
-	"master_ball":   IDMasterBall,
-	"masterball":    IDMasterBall,
-	"ultra_ball":    IDUltraBall,
-	"ultraball":     IDUltraBall,
-	"great_ball":    IDGreatBall,
-	"greatball":     IDGreatBall,
-	"poke_ball":     IDPokeBall,
-	"pokeball":      IDPokeBall,
-	"potion":        IDPotion,
-	"super_potion":  IDSuperPotion,
-	"superpotion":   IDSuperPotion,
-	"hyper_potion":  IDHyperPotion,
-	"hyperpotion":   IDHyperPotion,
-	"max_potion":    IDMaxPotion,
-	"maxpotion":     IDMaxPotion,
-	"full_restore":  IDFullRestore,
-	"fullrestore":   IDFullRestore,
-	"revive":        IDRevive,
-	"max_revive":    IDMaxRevive,
-	"maxrevive":     IDMaxRevive,
-	"escape_rope":   IDEscape,
-	"escaperope":    IDEscape,
-	"repel":         IDRepel,
-	"super_repel":   IDSuperRepel,
-	"superrepel":    IDSuperRepel,
-	"max_repel":     IDMaxRepel,
-	"maxrepel":      IDMaxRepel,
-	"antidote":      IDAntidote,
-	"burn_heal":     IDBurnHeal,
-	"burnheal":      IDBurnHeal,
-	"ice_heal":      IDIceHeal,
-	"iceheal":       IDIceHeal,
-	"awakening":     IDAwakening,
-	"paralyze_heal": IDParalyzeHeal,
-	"paralyzeheal":  IDParalyzeHeal,
-	"full_heal":     IDFullHeal,
-	"fullheal":      IDFullHeal,
+// registerName adds lookup keys for an item name, both with and without
+// underscores (e.g. "rare_candy" and "rarecandy")
+func registerName(name string, id byte) {
+	key := nameKey(name)
+	itemIDs[key] = id
+	itemIDs[strings.ReplaceAll(key, "_", "")] = id
 }
 
-// GetItemID returns the item ID for a given name (case-insensitive)
+// nameKey normalizes an item name into its lookup key ("Poké Ball" → "poke_ball")
+func nameKey(name string) string {
+	replacer := strings.NewReplacer(" ", "_", "é", "e", ".", "", "'", "")
+	return strings.Trim(replacer.Replace(strings.ToLower(strings.TrimSpace(name))), "_")
+}
+
+// GetItemID returns the item ID for a given name (case-insensitive).
+// Raw IDs such as "0x28" are also accepted, including glitch items, but
+// not 0x00 (no item) or 0xFF (the list terminator).
 func GetItemID(name string) (byte, error) {
 	normalized := strings.ToLower(strings.TrimSpace(name))
-	id, ok := itemIDs[normalized]
+	if strings.HasPrefix(normalized, "0x") {
+		id, err := strconv.ParseUint(normalized[2:], 16, 8)
+		if err != nil {
+			return 0, fmt.Errorf("invalid item ID: %s", name)
+		}
+		if err := ValidID(byte(id)); err != nil {
+			return 0, err
+		}
+		return byte(id), nil
+	}
+
+	id, ok := itemIDs[nameKey(normalized)]
 	if !ok {
 		return 0, fmt.Errorf("unknown item: %s", name)
 	}
 	return id, nil
 }
 
+// ValidID rejects the IDs that cannot be stored in an item list: 0x00 is
+// no item and 0xFF ends the list, so the game would stop reading there
+func ValidID(id byte) error {
+	switch id {
+	case 0x00:
+		return fmt.Errorf("invalid item ID 0x00: no item has this ID")
+	case 0xFF:
+		return fmt.Errorf("invalid item ID 0xFF: it marks the end of an item list")
+	}
+	return nil
+}
+
+// GetItemInfo returns the database entry for an item ID
+func GetItemInfo(id byte) ItemInfo {
+	return itemTable[id]
+}
+
 // GetItemName returns the human-readable name for an item ID
 func GetItemName(id byte) string {
-	name, ok := itemNames[id]
-	if !ok {
-		return fmt.Sprintf("Unknown Item (0x%02X)", id)
-	}
-	return name
+	return itemTable[id].Name
 }
 
-// IsValidItemID checks if an item ID is recognized
+// IsValidItemID checks if an item ID is a regular (non-glitch) item
 func IsValidItemID(id byte) bool {
-	_, ok := itemNames[id]
-	return ok
+	return itemTable[id].Category != CategoryGlitch
+}
+
+// IsGlitchItem reports whether an item ID is a glitch item
+func IsGlitchItem(id byte) bool {
+	return itemTable[id].Category == CategoryGlitch
+}
+
+// CheckQuantity applies the item's stacking rules to a requested quantity.
+// It returns an error for quantities the game does not allow, and warnings
+// for combinations that are allowed but risky (glitch items).
+func CheckQuantity(id byte, quantity byte) ([]string, error) {
+	info := itemTable[id]
+	if !info.Stackable && quantity > 1 {
+		return nil, fmt.Errorf("%s is a %s item and cannot be held more than once", info.Name, info.Category)
+	}
+
+	warnings := make([]string, 0)
+	if info.Category == CategoryGlitch {
+		warnings = append(warnings, fmt.Sprintf("0x%02X is a glitch item - using it in-game may corrupt your save", id))
+	}
+	return warnings, nil
 }
@@ -0,0 +1,127 @@
+package items
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/abravonunez/raracandy/internal/gen1/save"
+)
+
+func TestItemIDs(t *testing.T) {
+	tests := []struct {
+		name string
+		want byte
+	}{
+		{"rare_candy", 0x28},
+		{"RareCandy", 0x28},
+		{"super_potion", 0x13},
+		{"antidote", 0x0B},
+		{"revive", 0x35},
+		{"poke_ball", 0x04},
+		{"Poké Ball", 0x04},
+		{"S.S. Ticket", 0x3F},
+		{"oaks_parcel", 0x46},
+		{"ss_ticket", 0x3F},
+		{"hm01", 0xC4},
+		{"TM01", 0xC9},
+		{"tm50", 0xFA},
+		{"0x60", 0x60},
+	}
+
+	for _, tt := range tests {
+		got, err := GetItemID(tt.name)
+		if err != nil {
+			t.Errorf("GetItemID(%q) error = %v", tt.name, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("GetItemID(%q) = 0x%02X, want 0x%02X", tt.name, got, tt.want)
+		}
+	}
+
+	if _, err := GetItemID("missingno"); err == nil {
+		t.Error("GetItemID(missingno) should fail")
+	}
+}
+
+func TestInvalidItemIDs(t *testing.T) {
+	for _, id := range []byte{0x00, 0xFF} {
+		if _, err := GetItemID(fmt.Sprintf("0x%02x", id)); err == nil {
+			t.Errorf("GetItemID(0x%02X) should fail", id)
+		}
+
+		s := save.CreateTestSave()
+		before := GetBagItems(s)
+		if err := AddItem(s, id, 5); err == nil {
+			t.Errorf("AddItem(0x%02X) should fail", id)
+		}
+		if err := SetItemQuantity(s, id, 5); err == nil {
+			t.Errorf("SetItemQuantity(0x%02X) should fail", id)
+		}
+		if err := SetItems(s, PCBox(s), []Item{{ID: 0x14, Quantity: 1}, {ID: id, Quantity: 5}}); err == nil {
+			t.Errorf("SetItems() with 0x%02X should fail", id)
+		}
+		if got := GetBagItems(s); len(got) != len(before) || len(GetItems(s, PCBox(s))) != 0 {
+			t.Errorf("item lists changed after rejecting 0x%02X: %v", id, got)
+		}
+	}
+}
+
+func TestItemTable(t *testing.T) {
+	if name := GetItemName(0xCA); name != "TM02 (Razor Wind)" {
+		t.Errorf("GetItemName(0xCA) = %q", name)
+	}
+	if name := GetItemName(0xC6); name != "HM03 (Surf)" {
+		t.Errorf("GetItemName(0xC6) = %q", name)
+	}
+
+	glitch := 0
+	for id := 0; id < 256; id++ {
+		info := GetItemInfo(byte(id))
+		if info.Name == "" {
+			t.Errorf("item 0x%02X has no name", id)
+		}
+		if info.Category == CategoryGlitch {
+			glitch++
+		}
+	}
+	// 0x00, 0x2C, 0x32, 0x3B, 0x54-0xC3 and 0xFB-0xFF
+	if glitch != 1+3+112+5 {
+		t.Errorf("got %d glitch items, want %d", glitch, 1+3+112+5)
+	}
+
+	bike := GetItemInfo(IDBicycle)
+	if bike.Category != CategoryKey || bike.Stackable || bike.Tossable {
+		t.Errorf("Bicycle = %+v, want non-stackable, non-tossable key item", bike)
+	}
+	hm := GetItemInfo(IDHM01)
+	if hm.Stackable || hm.Tossable {
+		t.Errorf("HM01 = %+v, want non-stackable, non-tossable", hm)
+	}
+	if tm := GetItemInfo(IDTM01); !tm.Stackable || !tm.Tossable {
+		t.Errorf("TM01 = %+v, want stackable and tossable", tm)
+	}
+}
+
+func TestCheckQuantity(t *testing.T) {
+	if _, err := CheckQuantity(IDBicycle, 2); err == nil {
+		t.Error("CheckQuantity(Bicycle, 2) should fail")
+	}
+	if w, err := CheckQuantity(IDBicycle, 1); err != nil || len(w) != 0 {
+		t.Errorf("CheckQuantity(Bicycle, 1) = %v, %v", w, err)
+	}
+	if w, err := CheckQuantity(0x60, 5); err != nil || len(w) != 1 {
+		t.Errorf("CheckQuantity(glitch, 5) = %v, %v; want one warning", w, err)
+	}
+
+	s := save.CreateTestSave()
+	if err := AddItem(s, IDBicycle, 5); err == nil {
+		t.Error("AddItem() should refuse 5 Bicycles")
+	}
+	if err := AddItem(s, IDBicycle, 1); err != nil {
+		t.Fatalf("AddItem(Bicycle, 1) error = %v", err)
+	}
+	if err := SetItemQuantity(s, IDBicycle, 2); err == nil {
+		t.Error("SetItemQuantity() should refuse 2 Bicycles")
+	}
+}
@@ -0,0 +1,46 @@
+package money
+
+import (
+	"fmt"
+
+	"github.com/abravonunez/raracandy/internal/gen1/bcd"
+	"github.com/abravonunez/raracandy/internal/gen1/save"
+)
+
+const (
+	// MaxCoins is the most the Game Corner coin case can hold
+	MaxCoins = 9999
+
+	coinBytes = 2
+)
+
+// GetCoins reads the Game Corner coins, stored as 2 bytes of BCD
+func GetCoins(s *save.Save) uint32 {
+	bytes := s.GetBytes(s.GetProfile().OffsetCoins, coinBytes)
+	if len(bytes) != coinBytes {
+		return 0
+	}
+
+	// Invalid digits are reported by CheckIntegrity; decode them anyway
+	coins, _ := bcd.Decode(bytes)
+	return coins
+}
+
+// SetCoins encodes and writes the Game Corner coins
+func SetCoins(s *save.Save, coins uint32) error {
+	if coins > MaxCoins {
+		return fmt.Errorf("coins %d exceed maximum %d", coins, MaxCoins)
+	}
+
+	bytes, err := bcd.Encode(coins, coinBytes)
+	if err != nil {
+		return err
+	}
+	return s.SetBytes(s.GetProfile().OffsetCoins, bytes)
+}
+
+// FormatCoins returns a formatted coin count
+// Example: 1234 -> "1,234 coins"
+func FormatCoins(coins uint32) string {
+	return formatThousands(coins) + " coins"
+}
@@ -2,6 +2,9 @@ package money
 
 import (
 	"testing"
+
+	"github.com/abravonunez/raracandy/internal/gen1/bcd"
+	"github.com/abravonunez/raracandy/internal/gen1/save"
 )
 
 func TestBCDConversion(t *testing.T) {
@@ -19,23 +22,22 @@ func TestBCDConversion(t *testing.T) {
 
 	for _, tt := range tests {
 		// Test decimal to BCD
-		bcd := decimalToBCD(tt.decimal)
-		if bcd != tt.bcd {
-			t.Errorf("decimalToBCD(%d) = 0x%02X, want 0x%02X", tt.decimal, bcd, tt.bcd)
+		b := bcd.EncodeByte(tt.decimal)
+		if b != tt.bcd {
+			t.Errorf("bcd.EncodeByte(%d) = 0x%02X, want 0x%02X", tt.decimal, b, tt.bcd)
 		}
 
 		// Test BCD to decimal
-		dec := bcdToDecimal(tt.bcd)
+		dec := bcd.DecodeByte(tt.bcd)
 		if dec != tt.decimal {
-			t.Errorf("bcdToDecimal(0x%02X) = %d, want %d", tt.bcd, dec, tt.decimal)
+			t.Errorf("bcd.DecodeByte(0x%02X) = %d, want %d", tt.bcd, dec, tt.decimal)
 		}
 	}
 }
 
 func TestBCDRoundtrip(t *testing.T) {
 	for i := byte(0); i <= 99; i++ {
-		bcd := decimalToBCD(i)
-		dec := bcdToDecimal(bcd)
+		dec := bcd.DecodeByte(bcd.EncodeByte(i))
 		if dec != i {
 			t.Errorf("Roundtrip failed for %d: got %d", i, dec)
 		}
@@ -62,3 +64,23 @@ func TestFormatMoney(t *testing.T) {
 		}
 	}
 }
+
+func TestCoins(t *testing.T) {
+	s := save.CreateTestSave()
+
+	if err := SetCoins(s, 1234); err != nil {
+		t.Fatalf("SetCoins() error: %v", err)
+	}
+	if got := s.GetBytes(s.GetProfile().OffsetCoins, 2); got[0] != 0x12 || got[1] != 0x34 {
+		t.Errorf("coin bytes = % X, want 12 34", got)
+	}
+	if got := GetCoins(s); got != 1234 {
+		t.Errorf("GetCoins() = %d, want 1234", got)
+	}
+	if err := SetCoins(s, MaxCoins+1); err == nil {
+		t.Error("SetCoins() should reject more than 9999 coins")
+	}
+	if got := FormatCoins(9999); got != "9,999 coins" {
+		t.Errorf("FormatCoins(9999) = %q", got)
+	}
+}
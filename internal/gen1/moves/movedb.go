@@ -0,0 +1,124 @@
+package moves
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	// MaxMoveID is the highest valid move ID in Gen 1 (Struggle)
+	MaxMoveID = 165
+	// NoMove marks an empty move slot
+	NoMove = 0x00
+)
+
+// Move describes a move's name and base PP
+type Move struct {
+	ID     byte
+	Name   string
+	BasePP byte
+}
+
+// moveList holds every Gen 1 move in ID order (index 0 = move 0x01)
+var moveList = [MaxMoveID]Move{
+	{0x01, "Pound", 35}, {0x02, "Karate Chop", 25}, {0x03, "Double Slap", 10},
+	{0x04, "Comet Punch", 15}, {0x05, "Mega Punch", 20}, {0x06, "Pay Day", 20},
+	{0x07, "Fire Punch", 15}, {0x08, "Ice Punch", 15}, {0x09, "Thunder Punch", 15},
+	{0x0A, "Scratch", 35}, {0x0B, "Vice Grip", 30}, {0x0C, "Guillotine", 5},
+	{0x0D, "Razor Wind", 10}, {0x0E, "Swords Dance", 30}, {0x0F, "Cut", 30},
+	{0x10, "Gust", 35}, {0x11, "Wing Attack", 35}, {0x12, "Whirlwind", 20},
+	{0x13, "Fly", 15}, {0x14, "Bind", 20}, {0x15, "Slam", 20},
+	{0x16, "Vine Whip", 10}, {0x17, "Stomp", 20}, {0x18, "Double Kick", 30},
+	{0x19, "Mega Kick", 5}, {0x1A, "Jump Kick", 25}, {0x1B, "Rolling Kick", 15},
+	{0x1C, "Sand Attack", 15}, {0x1D, "Headbutt", 15}, {0x1E, "Horn Attack", 25},
+	{0x1F, "Fury Attack", 20}, {0x20, "Horn Drill", 5}, {0x21, "Tackle", 35},
+	{0x22, "Body Slam", 15}, {0x23, "Wrap", 20}, {0x24, "Take Down", 20},
+	{0x25, "Thrash", 20}, {0x26, "Double-Edge", 15}, {0x27, "Tail Whip", 30},
+	{0x28, "Poison Sting", 35}, {0x29, "Twineedle", 20}, {0x2A, "Pin Missile", 20},
+	{0x2B, "Leer", 30}, {0x2C, "Bite", 25}, {0x2D, "Growl", 40},
+	{0x2E, "Roar", 20}, {0x2F, "Sing", 15}, {0x30, "Supersonic", 20},
+	{0x31, "Sonic Boom", 20}, {0x32, "Disable", 20}, {0x33, "Acid", 30},
+	{0x34, "Ember", 25}, {0x35, "Flamethrower", 15}, {0x36, "Mist", 30},
+	{0x37, "Water Gun", 25}, {0x38, "Hydro Pump", 5}, {0x39, "Surf", 15},
+	{0x3A, "Ice Beam", 10}, {0x3B, "Blizzard", 5}, {0x3C, "Psybeam", 20},
+	{0x3D, "Bubble Beam", 20}, {0x3E, "Aurora Beam", 20}, {0x3F, "Hyper Beam", 5},
+	{0x40, "Peck", 35}, {0x41, "Drill Peck", 20}, {0x42, "Submission", 25},
+	{0x43, "Low Kick", 20}, {0x44, "Counter", 20}, {0x45, "Seismic Toss", 20},
+	{0x46, "Strength", 15}, {0x47, "Absorb", 20}, {0x48, "Mega Drain", 10},
+	{0x49, "Leech Seed", 10}, {0x4A, "Growth", 40}, {0x4B, "Razor Leaf", 25},
+	{0x4C, "Solar Beam", 10}, {0x4D, "Poison Powder", 35}, {0x4E, "Stun Spore", 30},
+	{0x4F, "Sleep Powder", 15}, {0x50, "Petal Dance", 20}, {0x51, "String Shot", 40},
+	{0x52, "Dragon Rage", 10}, {0x53, "Fire Spin", 15}, {0x54, "Thunder Shock", 30},
+	{0x55, "Thunderbolt", 15}, {0x56, "Thunder Wave", 20}, {0x57, "Thunder", 10},
+	{0x58, "Rock Throw", 15}, {0x59, "Earthquake", 10}, {0x5A, "Fissure", 5},
+	{0x5B, "Dig", 10}, {0x5C, "Toxic", 10}, {0x5D, "Confusion", 25},
+	{0x5E, "Psychic", 10}, {0x5F, "Hypnosis", 20}, {0x60, "Meditate", 40},
+	{0x61, "Agility", 30}, {0x62, "Quick Attack", 30}, {0x63, "Rage", 20},
+	{0x64, "Teleport", 20}, {0x65, "Night Shade", 15}, {0x66, "Mimic", 10},
+	{0x67, "Screech", 40}, {0x68, "Double Team", 15}, {0x69, "Recover", 20},
+	{0x6A, "Harden", 30}, {0x6B, "Minimize", 20}, {0x6C, "Smokescreen", 20},
+	{0x6D, "Confuse Ray", 10}, {0x6E, "Withdraw", 40}, {0x6F, "Defense Curl", 40},
+	{0x70, "Barrier", 30}, {0x71, "Light Screen", 30}, {0x72, "Haze", 30},
+	{0x73, "Reflect", 20}, {0x74, "Focus Energy", 30}, {0x75, "Bide", 10},
+	{0x76, "Metronome", 10}, {0x77, "Mirror Move", 20}, {0x78, "Self-Destruct", 5},
+	{0x79, "Egg Bomb", 10}, {0x7A, "Lick", 30}, {0x7B, "Smog", 20},
+	{0x7C, "Sludge", 20}, {0x7D, "Bone Club", 20}, {0x7E, "Fire Blast", 5},
+	{0x7F, "Waterfall", 15}, {0x80, "Clamp", 10}, {0x81, "Swift", 20},
+	{0x82, "Skull Bash", 15}, {0x83, "Spike Cannon", 15}, {0x84, "Constrict", 35},
+	{0x85, "Amnesia", 20}, {0x86, "Kinesis", 15}, {0x87, "Soft-Boiled", 10},
+	{0x88, "High Jump Kick", 20}, {0x89, "Glare", 30}, {0x8A, "Dream Eater", 15},
+	{0x8B, "Poison Gas", 40}, {0x8C, "Barrage", 20}, {0x8D, "Leech Life", 15},
+	{0x8E, "Lovely Kiss", 10}, {0x8F, "Sky Attack", 5}, {0x90, "Transform", 10},
+	{0x91, "Bubble", 30}, {0x92, "Dizzy Punch", 10}, {0x93, "Spore", 15},
+	{0x94, "Flash", 20}, {0x95, "Psywave", 15}, {0x96, "Splash", 40},
+	{0x97, "Acid Armor", 40}, {0x98, "Crabhammer", 10}, {0x99, "Explosion", 5},
+	{0x9A, "Fury Swipes", 15}, {0x9B, "Bonemerang", 10}, {0x9C, "Rest", 10},
+	{0x9D, "Rock Slide", 10}, {0x9E, "Hyper Fang", 15}, {0x9F, "Sharpen", 30},
+	{0xA0, "Conversion", 30}, {0xA1, "Tri Attack", 10}, {0xA2, "Super Fang", 10},
+	{0xA3, "Slash", 20}, {0xA4, "Substitute", 10}, {0xA5, "Struggle", 10},
+}
+
+// moveIDs maps normalized move names to IDs, built at init
+var moveIDs = make(map[string]byte, MaxMoveID)
+
+func init() {
+	for _, m := range moveList {
+		moveIDs[normalizeName(m.Name)] = m.ID
+	}
+}
+
+// normalizeName lowercases a name and strips separators so that
+// "Double-Edge", "double_edge" and "doubleedge" all match
+func normalizeName(name string) string {
+	replacer := strings.NewReplacer(" ", "", "_", "", "-", "")
+	return replacer.Replace(strings.ToLower(strings.TrimSpace(name)))
+}
+
+// Get returns the move for an ID
+func Get(id byte) (Move, bool) {
+	if id == NoMove || int(id) > MaxMoveID {
+		return Move{}, false
+	}
+	return moveList[id-1], true
+}
+
+// GetMoveID returns the move ID for a given name (case-insensitive)
+func GetMoveID(name string) (byte, error) {
+	id, ok := moveIDs[normalizeName(name)]
+	if !ok {
+		return 0, fmt.Errorf("unknown move: %s", name)
+	}
+	return id, nil
+}
+
+// GetMoveName returns the human-readable name for a move ID
+func GetMoveName(id byte) string {
+	if id == NoMove {
+		return "-"
+	}
+	m, ok := Get(id)
+	if !ok {
+		return fmt.Sprintf("Unknown Move (0x%02X)", id)
+	}
+	return m.Name
+}
@@ -0,0 +1,196 @@
+package options
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/abravonunez/raracandy/internal/gen1/profile"
+	"github.com/abravonunez/raracandy/internal/gen1/save"
+)
+
+// TextSpeed is the delay, in frames, between two printed characters
+type TextSpeed byte
+
+const (
+	TextFast   TextSpeed = profile.TextDelayFast
+	TextMedium TextSpeed = profile.TextDelayMedium
+	TextSlow   TextSpeed = profile.TextDelaySlow
+)
+
+func (t TextSpeed) String() string {
+	switch t {
+	case TextFast:
+		return "fast"
+	case TextMedium:
+		return "medium"
+	case TextSlow:
+		return "slow"
+	default:
+		return fmt.Sprintf("custom (%d)", byte(t))
+	}
+}
+
+// Valid reports whether the options menu can select this speed
+func (t TextSpeed) Valid() bool {
+	return profile.ValidTextDelay(byte(t))
+}
+
+// ParseTextSpeed parses fast, medium or slow
+func ParseTextSpeed(s string) (TextSpeed, error) {
+	switch strings.ToLower(s) {
+	case "fast":
+		return TextFast, nil
+	case "medium":
+		return TextMedium, nil
+	case "slow":
+		return TextSlow, nil
+	default:
+		return 0, fmt.Errorf("unknown text speed %q (expected fast, medium or slow)", s)
+	}
+}
+
+// BattleStyle is whether the player may switch Pokémon when the opponent's faints
+type BattleStyle byte
+
+const (
+	BattleShift BattleStyle = iota
+	BattleSet
+)
+
+func (b BattleStyle) String() string {
+	if b == BattleSet {
+		return "set"
+	}
+	return "shift"
+}
+
+// ParseBattleStyle parses shift or set
+func ParseBattleStyle(s string) (BattleStyle, error) {
+	switch strings.ToLower(s) {
+	case "shift":
+		return BattleShift, nil
+	case "set":
+		return BattleSet, nil
+	default:
+		return 0, fmt.Errorf("unknown battle style %q (expected shift or set)", s)
+	}
+}
+
+// Sound is Yellow's audio output setting. Red/Blue leave these bits at 0.
+type Sound byte
+
+const (
+	SoundMono Sound = iota
+	SoundEarphone1
+	SoundEarphone2
+	SoundEarphone3
+)
+
+func (s Sound) String() string {
+	switch s {
+	case SoundMono:
+		return "mono"
+	case SoundEarphone1, SoundEarphone2, SoundEarphone3:
+		return fmt.Sprintf("earphone%d", byte(s))
+	default:
+		return fmt.Sprintf("unknown (%d)", byte(s))
+	}
+}
+
+// ParseSound parses mono or earphone1-3
+func ParseSound(s string) (Sound, error) {
+	for sound := SoundMono; sound <= SoundEarphone3; sound++ {
+		if strings.EqualFold(s, sound.String()) {
+			return sound, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown sound %q (expected mono, earphone1, earphone2 or earphone3)", s)
+}
+
+// Options is the decoded options byte
+type Options struct {
+	TextSpeed   TextSpeed
+	Animations  bool
+	BattleStyle BattleStyle
+	Sound       Sound
+}
+
+func (o Options) String() string {
+	animations := "on"
+	if !o.Animations {
+		animations = "off"
+	}
+	return fmt.Sprintf("text speed %s, animations %s, battle style %s, sound %s", o.TextSpeed, animations, o.BattleStyle, o.Sound)
+}
+
+// Decode unpacks an options byte
+func Decode(b byte) Options {
+	o := Options{
+		TextSpeed:  TextSpeed(b & profile.OptionsTextDelayMask),
+		Animations: b&profile.OptionsAnimationsBit == 0,
+		Sound:      Sound((b & profile.OptionsSoundMask) >> profile.OptionsSoundShift),
+	}
+	if b&profile.OptionsBattleStyleBit != 0 {
+		o.BattleStyle = BattleSet
+	}
+	return o
+}
+
+// Encode packs the options into a byte. Out-of-range fields are masked;
+// call Validate first to reject them.
+func (o Options) Encode() byte {
+	b := byte(o.TextSpeed)&profile.OptionsTextDelayMask | byte(o.Sound)<<profile.OptionsSoundShift&profile.OptionsSoundMask
+	if o.BattleStyle == BattleSet {
+		b |= profile.OptionsBattleStyleBit
+	}
+	if !o.Animations {
+		b |= profile.OptionsAnimationsBit
+	}
+	return b
+}
+
+// Validate rejects settings the game's options menu cannot produce. Sound
+// is only accepted on Yellow; game GameAuto skips that check.
+func (o Options) Validate(game profile.Game) error {
+	return o.validate(game, nil)
+}
+
+// ValidateChanges is Validate limited to the settings that differ from
+// current, so that an odd value already in the save (a custom text speed,
+// sound bits on Red/Blue) does not block changing another setting
+func (o Options) ValidateChanges(current Options, game profile.Game) error {
+	return o.validate(game, &current)
+}
+
+// validate checks every setting, or with current only the changed ones
+func (o Options) validate(game profile.Game, current *Options) error {
+	if (current == nil || o.TextSpeed != current.TextSpeed) && !o.TextSpeed.Valid() {
+		return fmt.Errorf("text speed %d is not fast (1), medium (3) or slow (5)", byte(o.TextSpeed))
+	}
+	if (current == nil || o.BattleStyle != current.BattleStyle) && o.BattleStyle > BattleSet {
+		return fmt.Errorf("unknown battle style %d", byte(o.BattleStyle))
+	}
+	if current == nil || o.Sound != current.Sound {
+		if o.Sound > SoundEarphone3 {
+			return fmt.Errorf("unknown sound %d", byte(o.Sound))
+		}
+		if game == profile.GameRedBlue && o.Sound != SoundMono {
+			return fmt.Errorf("sound %s is only available in Pokémon Yellow", o.Sound)
+		}
+	}
+	return nil
+}
+
+// Get reads the options byte
+func Get(s *save.Save) Options {
+	return Decode(s.GetByte(s.GetProfile().OffsetOptions))
+}
+
+// Set validates the settings that change for the save's game and writes
+// the options
+func Set(s *save.Save, o Options) error {
+	if err := o.ValidateChanges(Get(s), s.GetProfile().Version.Game()); err != nil {
+		return err
+	}
+	return s.SetByte(s.GetProfile().OffsetOptions, o.Encode())
+}
@@ -0,0 +1,104 @@
+package options
+
+import (
+	"testing"
+
+	"github.com/abravonunez/raracandy/internal/gen1/profile"
+	"github.com/abravonunez/raracandy/internal/gen1/save"
+)
+
+func TestDecodeEncode(t *testing.T) {
+	tests := []struct {
+		b    byte
+		want Options
+	}{
+		{0x03, Options{TextSpeed: TextMedium, Animations: true, BattleStyle: BattleShift, Sound: SoundMono}},
+		{0xC1, Options{TextSpeed: TextFast, Animations: false, BattleStyle: BattleSet, Sound: SoundMono}},
+		{0x25, Options{TextSpeed: TextSlow, Animations: true, BattleStyle: BattleShift, Sound: SoundEarphone2}},
+	}
+
+	for _, tt := range tests {
+		got := Decode(tt.b)
+		if got != tt.want {
+			t.Errorf("Decode(0x%02X) = %+v, want %+v", tt.b, got, tt.want)
+		}
+		if b := got.Encode(); b != tt.b {
+			t.Errorf("Encode(%+v) = 0x%02X, want 0x%02X", got, b, tt.b)
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	valid := Options{TextSpeed: TextFast, Animations: true}
+	if err := valid.Validate(profile.GameRedBlue); err != nil {
+		t.Errorf("Validate() error: %v", err)
+	}
+
+	custom := Options{TextSpeed: 0}
+	if err := custom.Validate(profile.GameYellow); err == nil {
+		t.Error("Validate() should reject text speed 0")
+	}
+
+	sound := Options{TextSpeed: TextFast, Sound: SoundEarphone1}
+	if err := sound.Validate(profile.GameYellow); err != nil {
+		t.Errorf("Validate() error on Yellow: %v", err)
+	}
+	if err := sound.Validate(profile.GameRedBlue); err == nil {
+		t.Error("Validate() should reject sound on Red/Blue")
+	}
+}
+
+func TestParse(t *testing.T) {
+	if speed, err := ParseTextSpeed("Fast"); err != nil || speed != TextFast {
+		t.Errorf("ParseTextSpeed(Fast) = %v, %v", speed, err)
+	}
+	if style, err := ParseBattleStyle("set"); err != nil || style != BattleSet {
+		t.Errorf("ParseBattleStyle(set) = %v, %v", style, err)
+	}
+	if sound, err := ParseSound("earphone3"); err != nil || sound != SoundEarphone3 {
+		t.Errorf("ParseSound(earphone3) = %v, %v", sound, err)
+	}
+	if _, err := ParseTextSpeed("instant"); err == nil {
+		t.Error("ParseTextSpeed(instant) should fail")
+	}
+}
+
+func TestSet(t *testing.T) {
+	s := save.CreateTestSave()
+
+	o := Options{TextSpeed: TextFast, Animations: false, BattleStyle: BattleSet}
+	if err := Set(s, o); err != nil {
+		t.Fatalf("Set() error: %v", err)
+	}
+	if got := s.GetByte(s.GetProfile().OffsetOptions); got != 0xC1 {
+		t.Errorf("options byte = 0x%02X, want 0xC1", got)
+	}
+	if got := Get(s); got != o {
+		t.Errorf("Get() = %+v, want %+v", got, o)
+	}
+}
+
+func TestSetKeepsUnchangedSettings(t *testing.T) {
+	// A Red/Blue save with a custom text speed and stray sound bits
+	s := save.CreateTestSaveFor(profile.ProfileRedBlueNA)
+	s.SetByte(s.GetProfile().OffsetOptions, 0x12)
+
+	o := Get(s)
+	o.BattleStyle = BattleSet
+	if err := Set(s, o); err != nil {
+		t.Fatalf("Set() should only check the battle style: %v", err)
+	}
+	if got := s.GetByte(s.GetProfile().OffsetOptions); got != 0x52 {
+		t.Errorf("options byte = 0x%02X, want 0x52", got)
+	}
+
+	o.Sound = SoundEarphone2
+	if err := Set(s, o); err == nil {
+		t.Error("Set() should reject changing the sound on Red/Blue")
+	}
+	o = Get(s)
+	o.TextSpeed = 4
+	if err := o.ValidateChanges(Get(s), profile.GameRedBlue); err == nil {
+		t.Error("ValidateChanges() should reject a changed custom text speed")
+	}
+}
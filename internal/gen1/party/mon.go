@@ -0,0 +1,175 @@
+package party
+
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/abravonunez/raracandy/internal/gen1/species"
+)
+
+const (
+	// MonSize is the size of the structure shared by party, box and daycare slots
+	MonSize = 33
+	// PartyMonSize is MonSize plus the level and stats cached by party slots
+	PartyMonSize = 44
+
+	MaxLevel = 100
+	MaxDV    = 15
+)
+
+// Stats holds one value per Gen 1 stat (used for both stats and stat experience)
+type Stats struct {
+	HP      uint16
+	Attack  uint16
+	Defense uint16
+	Speed   uint16
+	Special uint16
+}
+
+// DVs are the Gen 1 determinant values (0-15). The HP DV is derived from the others.
+type DVs struct {
+	Attack  byte
+	Defense byte
+	Speed   byte
+	Special byte
+}
+
+// HP returns the HP DV, built from the lowest bit of each other DV
+func (d DVs) HP() byte {
+	return (d.Attack&1)<<3 | (d.Defense&1)<<2 | (d.Speed&1)<<1 | d.Special&1
+}
+
+// Mon is the 33-byte Pokémon structure used by box slots and as the
+// first part of the 44-byte party structure
+type Mon struct {
+	Species   byte
+	HP        uint16
+	BoxLevel  byte
+	Status    byte
+	Type1     byte
+	Type2     byte
+	CatchRate byte
+	Moves     [4]byte
+	OTID      uint16
+	Exp       uint32
+	StatExp   Stats
+	DVs       DVs
+	PP        [4]byte
+}
+
+// PartyMon is a party slot: the shared structure, the cached level and
+// stats, and the slot's OT name and nickname (raw, 0x50-terminated)
+type PartyMon struct {
+	Mon
+	Level    byte
+	Stats    Stats
+	OTName   []byte
+	Nickname []byte
+}
+
+// DecodeMon decodes a 33-byte Pokémon structure
+func DecodeMon(b []byte) Mon {
+	var m Mon
+	m.Species = b[0x00]
+	m.HP = binary.BigEndian.Uint16(b[0x01:])
+	m.BoxLevel = b[0x03]
+	m.Status = b[0x04]
+	m.Type1 = b[0x05]
+	m.Type2 = b[0x06]
+	m.CatchRate = b[0x07]
+	copy(m.Moves[:], b[0x08:0x0C])
+	m.OTID = binary.BigEndian.Uint16(b[0x0C:])
+	m.Exp = uint32(b[0x0E])<<16 | uint32(b[0x0F])<<8 | uint32(b[0x10])
+	m.StatExp = decodeStats(b[0x11:])
+	m.DVs = DVs{
+		Attack:  b[0x1B] >> 4,
+		Defense: b[0x1B] & 0x0F,
+		Speed:   b[0x1C] >> 4,
+		Special: b[0x1C] & 0x0F,
+	}
+	copy(m.PP[:], b[0x1D:0x21])
+	return m
+}
+
+// Encode returns the 33-byte representation of the structure
+func (m Mon) Encode() []byte {
+	b := make([]byte, MonSize)
+	b[0x00] = m.Species
+	binary.BigEndian.PutUint16(b[0x01:], m.HP)
+	b[0x03] = m.BoxLevel
+	b[0x04] = m.Status
+	b[0x05] = m.Type1
+	b[0x06] = m.Type2
+	b[0x07] = m.CatchRate
+	copy(b[0x08:0x0C], m.Moves[:])
+	binary.BigEndian.PutUint16(b[0x0C:], m.OTID)
+	b[0x0E] = byte(m.Exp >> 16)
+	b[0x0F] = byte(m.Exp >> 8)
+	b[0x10] = byte(m.Exp)
+	encodeStats(b[0x11:], m.StatExp)
+	b[0x1B] = m.DVs.Attack<<4 | m.DVs.Defense&0x0F
+	b[0x1C] = m.DVs.Speed<<4 | m.DVs.Special&0x0F
+	copy(b[0x1D:0x21], m.PP[:])
+	return b
+}
+
+// DecodePartyMon decodes a 44-byte party structure (names are left empty)
+func DecodePartyMon(b []byte) PartyMon {
+	return PartyMon{
+		Mon:   DecodeMon(b[:MonSize]),
+		Level: b[0x21],
+		Stats: decodeStats(b[0x22:]),
+	}
+}
+
+// Encode returns the 44-byte party structure (names are stored separately)
+func (p PartyMon) Encode() []byte {
+	b := make([]byte, PartyMonSize)
+	copy(b, p.Mon.Encode())
+	b[0x21] = p.Level
+	encodeStats(b[0x22:], p.Stats)
+	return b
+}
+
+// RecalculateStats recomputes the cached stats from species, level, DVs and stat experience
+func (p *PartyMon) RecalculateStats() {
+	sp, ok := species.ByIndex(p.Species)
+	if !ok {
+		return
+	}
+	level := int(p.Level)
+	p.Stats = Stats{
+		HP:      calcStat(sp.Base.HP, p.DVs.HP(), p.StatExp.HP, level) + uint16(level) + 5,
+		Attack:  calcStat(sp.Base.Attack, p.DVs.Attack, p.StatExp.Attack, level),
+		Defense: calcStat(sp.Base.Defense, p.DVs.Defense, p.StatExp.Defense, level),
+		Speed:   calcStat(sp.Base.Speed, p.DVs.Speed, p.StatExp.Speed, level),
+		Special: calcStat(sp.Base.Special, p.DVs.Special, p.StatExp.Special, level),
+	}
+}
+
+// calcStat applies the Gen 1 stat formula (the HP bonus is added by the caller)
+func calcStat(base int, dv byte, statExp uint16, level int) uint16 {
+	root := int(math.Ceil(math.Sqrt(float64(statExp))))
+	if root > 255 {
+		root = 255
+	}
+	return uint16(((base+int(dv))*2+root/4)*level/100 + 5)
+}
+
+func decodeStats(b []byte) Stats {
+	return Stats{
+		HP:      binary.BigEndian.Uint16(b[0:]),
+		Attack:  binary.BigEndian.Uint16(b[2:]),
+		Defense: binary.BigEndian.Uint16(b[4:]),
+		Speed:   binary.BigEndian.Uint16(b[6:]),
+		Special: binary.BigEndian.Uint16(b[8:]),
+	}
+}
+
+func encodeStats(b []byte, s Stats) {
+	binary.BigEndian.PutUint16(b[0:], s.HP)
+	binary.BigEndian.PutUint16(b[2:], s.Attack)
+	binary.BigEndian.PutUint16(b[4:], s.Defense)
+	binary.BigEndian.PutUint16(b[6:], s.Speed)
+	binary.BigEndian.PutUint16(b[8:], s.Special)
+}
@@ -0,0 +1,254 @@
+package party
+
+import (
+	"fmt"
+
+	"github.com/abravonunez/raracandy/internal/gen1/moves"
+	"github.com/abravonunez/raracandy/internal/gen1/save"
+	"github.com/abravonunez/raracandy/internal/gen1/species"
+)
+
+const (
+	// SpeciesListTerminator ends the party species list
+	SpeciesListTerminator = 0xFF
+)
+
+// layout holds the offsets of each party sub-block for the save's profile
+type layout struct {
+	count    int
+	species  int
+	structs  int
+	otNames  int
+	nicks    int
+	maxMons  int
+	nameSize int
+}
+
+func getLayout(s *save.Save) layout {
+	prof := s.GetProfile()
+	l := layout{
+		count:    prof.OffsetParty,
+		species:  prof.OffsetParty + 1,
+		maxMons:  prof.MaxPartyMons,
+		nameSize: prof.NameLength,
+	}
+	l.structs = l.species + l.maxMons + 1
+	l.otNames = l.structs + l.maxMons*PartyMonSize
+	l.nicks = l.otNames + l.maxMons*l.nameSize
+	return l
+}
+
+// Count returns the number of Pokémon in the party
+func Count(s *save.Save) int {
+	l := getLayout(s)
+	count := int(s.GetByte(l.count))
+	if count > l.maxMons {
+		count = l.maxMons
+	}
+	return count
+}
+
+// GetParty returns every Pokémon currently in the party
+func GetParty(s *save.Save) []PartyMon {
+	count := Count(s)
+	mons := make([]PartyMon, 0, count)
+	for slot := 0; slot < count; slot++ {
+		mons = append(mons, readSlot(s, slot))
+	}
+	return mons
+}
+
+// GetPartyMon returns the Pokémon in a party slot (0-based)
+func GetPartyMon(s *save.Save, slot int) (PartyMon, error) {
+	if err := checkSlot(s, slot); err != nil {
+		return PartyMon{}, err
+	}
+	return readSlot(s, slot), nil
+}
+
+// SetPartyMon overwrites an occupied party slot, including its names and
+// the matching entry in the species list
+func SetPartyMon(s *save.Save, slot int, mon PartyMon) error {
+	if err := checkSlot(s, slot); err != nil {
+		return err
+	}
+
+	l := getLayout(s)
+	if err := s.SetBytes(l.structs+slot*PartyMonSize, mon.Encode()); err != nil {
+		return fmt.Errorf("failed to write party struct: %w", err)
+	}
+	if err := s.SetByte(l.species+slot, mon.Species); err != nil {
+		return fmt.Errorf("failed to update species list: %w", err)
+	}
+	if mon.OTName != nil {
+		if err := s.SetBytes(l.otNames+slot*l.nameSize, padName(mon.OTName, l.nameSize)); err != nil {
+			return fmt.Errorf("failed to write OT name: %w", err)
+		}
+	}
+	if mon.Nickname != nil {
+		if err := s.SetBytes(l.nicks+slot*l.nameSize, padName(mon.Nickname, l.nameSize)); err != nil {
+			return fmt.Errorf("failed to write nickname: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// SetParty replaces the whole party: count, species list, structures and
+// names. Unused slots are filled like the game does.
+func SetParty(s *save.Save, mons []PartyMon) error {
+	l := getLayout(s)
+	if len(mons) > l.maxMons {
+		return fmt.Errorf("party can hold at most %d Pokémon, got %d", l.maxMons, len(mons))
+	}
+
+	species := make([]byte, l.maxMons+1)
+	for i := range species {
+		species[i] = SpeciesListTerminator
+	}
+	structs := make([]byte, l.maxMons*PartyMonSize)
+	names := make([]byte, 2*l.maxMons*l.nameSize)
+	for i := range names {
+		names[i] = 0x50
+	}
+
+	for i, mon := range mons {
+		species[i] = mon.Species
+		copy(structs[i*PartyMonSize:], mon.Encode())
+		copy(names[i*l.nameSize:(i+1)*l.nameSize], padName(mon.OTName, l.nameSize))
+		copy(names[(l.maxMons+i)*l.nameSize:(l.maxMons+i+1)*l.nameSize], padName(mon.Nickname, l.nameSize))
+	}
+
+	if err := s.SetByte(l.count, byte(len(mons))); err != nil {
+		return fmt.Errorf("failed to write party count: %w", err)
+	}
+	if err := s.SetBytes(l.species, species); err != nil {
+		return fmt.Errorf("failed to write species list: %w", err)
+	}
+	if err := s.SetBytes(l.structs, structs); err != nil {
+		return fmt.Errorf("failed to write party structs: %w", err)
+	}
+	if err := s.SetBytes(l.otNames, names); err != nil {
+		return fmt.Errorf("failed to write party names: %w", err)
+	}
+	return nil
+}
+
+// SetLevel changes a party Pokémon's level, setting its experience to the
+// minimum for that level, recalculating its stats and restoring full HP
+func SetLevel(s *save.Save, slot int, level int) error {
+	if level < 1 || level > MaxLevel {
+		return fmt.Errorf("level %d out of range (1-%d)", level, MaxLevel)
+	}
+
+	mon, err := GetPartyMon(s, slot)
+	if err != nil {
+		return err
+	}
+	sp, ok := species.ByIndex(mon.Species)
+	if !ok {
+		return fmt.Errorf("cannot set level of unknown species 0x%02X", mon.Species)
+	}
+
+	mon.Level = byte(level)
+	mon.BoxLevel = byte(level)
+	mon.Exp = sp.GrowthRate.ExpForLevel(level)
+	mon.RecalculateStats()
+	mon.HP = mon.Stats.HP
+
+	return SetPartyMon(s, slot, mon)
+}
+
+// SetMoves replaces a party Pokémon's moves. Slots whose move changes get
+// the move's base PP and lose any PP Ups; empty slots must come last.
+func SetMoves(s *save.Save, slot int, newMoves [4]byte) error {
+	if newMoves[0] == moves.NoMove {
+		return fmt.Errorf("a Pokémon must know at least one move")
+	}
+	seen := make(map[byte]bool, 4)
+	empty := false
+	for i, id := range newMoves {
+		if id == moves.NoMove {
+			empty = true
+			continue
+		}
+		if empty {
+			return fmt.Errorf("move %d follows an empty slot", i+1)
+		}
+		if _, ok := moves.Get(id); !ok {
+			return fmt.Errorf("invalid move ID 0x%02X", id)
+		}
+		if seen[id] {
+			return fmt.Errorf("duplicate move: %s", moves.GetMoveName(id))
+		}
+		seen[id] = true
+	}
+
+	mon, err := GetPartyMon(s, slot)
+	if err != nil {
+		return err
+	}
+
+	for i, id := range newMoves {
+		if mon.Moves[i] == id {
+			continue
+		}
+		mon.Moves[i] = id
+		mon.PP[i] = 0
+		if m, ok := moves.Get(id); ok {
+			mon.PP[i] = m.BasePP
+		}
+	}
+
+	return SetPartyMon(s, slot, mon)
+}
+
+// SetDVs replaces a party Pokémon's DVs and recalculates its stats.
+// A Pokémon at full HP stays at full HP; otherwise HP is capped at the new maximum.
+func SetDVs(s *save.Save, slot int, dvs DVs) error {
+	for _, dv := range []byte{dvs.Attack, dvs.Defense, dvs.Speed, dvs.Special} {
+		if dv > MaxDV {
+			return fmt.Errorf("DV %d out of range (0-%d)", dv, MaxDV)
+		}
+	}
+
+	mon, err := GetPartyMon(s, slot)
+	if err != nil {
+		return err
+	}
+
+	fullHP := mon.HP == mon.Stats.HP
+	mon.DVs = dvs
+	mon.RecalculateStats()
+	if fullHP || mon.HP > mon.Stats.HP {
+		mon.HP = mon.Stats.HP
+	}
+
+	return SetPartyMon(s, slot, mon)
+}
+
+func checkSlot(s *save.Save, slot int) error {
+	count := Count(s)
+	if slot < 0 || slot >= count {
+		return fmt.Errorf("party slot %d is empty (party has %d Pokémon)", slot+1, count)
+	}
+	return nil
+}
+
+func readSlot(s *save.Save, slot int) PartyMon {
+	l := getLayout(s)
+	mon := DecodePartyMon(s.GetBytes(l.structs+slot*PartyMonSize, PartyMonSize))
+	mon.OTName = s.GetBytes(l.otNames+slot*l.nameSize, l.nameSize)
+	mon.Nickname = s.GetBytes(l.nicks+slot*l.nameSize, l.nameSize)
+	return mon
+}
+
+// padName fits a raw name into its fixed-size field, padding with terminators
+func padName(name []byte, size int) []byte {
+	b := make([]byte, size)
+	for i := range b {
+		b[i] = 0x50
+	}
+	copy(b, name)
+	return b
+}
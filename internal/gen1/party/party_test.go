@@ -0,0 +1,145 @@
+package party
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/abravonunez/raracandy/internal/gen1/save"
+	"github.com/abravonunez/raracandy/internal/gen1/species"
+)
+
+// newPartySave returns a test save with a single level 5 Pikachu in the party
+func newPartySave(t *testing.T) *save.Save {
+	t.Helper()
+	s := save.CreateTestSave()
+	prof := s.GetProfile()
+
+	pikachu, err := species.GetIndex("pikachu")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mon := PartyMon{
+		Mon: Mon{
+			Species:  pikachu,
+			BoxLevel: 5,
+			Moves:    [4]byte{0x54, 0x2D}, // Thunder Shock, Growl
+			PP:       [4]byte{30, 40},
+			Exp:      125,
+			DVs:      DVs{Attack: 10, Defense: 9, Speed: 8, Special: 7},
+		},
+		Level: 5,
+	}
+	mon.RecalculateStats()
+	mon.HP = mon.Stats.HP
+
+	s.SetByte(prof.OffsetParty, 1)
+	s.SetBytes(prof.OffsetParty+1, []byte{pikachu, SpeciesListTerminator})
+	s.SetBytes(prof.OffsetParty+8, mon.Encode())
+	return s
+}
+
+func TestMonRoundtrip(t *testing.T) {
+	raw := make([]byte, PartyMonSize)
+	for i := range raw {
+		raw[i] = byte(i*7 + 3)
+	}
+
+	mon := DecodePartyMon(raw)
+	if !bytes.Equal(mon.Encode(), raw) {
+		t.Errorf("Encode(DecodePartyMon(b)) != b\n got: % X\nwant: % X", mon.Encode(), raw)
+	}
+}
+
+func TestRecalculateStats(t *testing.T) {
+	mewtwo, _ := species.GetIndex("mewtwo")
+	mon := PartyMon{
+		Mon: Mon{
+			Species: mewtwo,
+			StatExp: Stats{65535, 65535, 65535, 65535, 65535},
+			DVs:     DVs{15, 15, 15, 15},
+		},
+		Level: 100,
+	}
+	mon.RecalculateStats()
+
+	want := Stats{HP: 415, Attack: 318, Defense: 278, Speed: 358, Special: 406}
+	if mon.Stats != want {
+		t.Errorf("RecalculateStats() = %+v, want %+v", mon.Stats, want)
+	}
+}
+
+func TestSetLevel(t *testing.T) {
+	s := newPartySave(t)
+
+	if err := SetLevel(s, 0, 50); err != nil {
+		t.Fatalf("SetLevel() error = %v", err)
+	}
+
+	mon, err := GetPartyMon(s, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if mon.Level != 50 || mon.BoxLevel != 50 {
+		t.Errorf("level = %d/%d, want 50/50", mon.Level, mon.BoxLevel)
+	}
+	if mon.Exp != 125000 {
+		t.Errorf("exp = %d, want 125000 (medium fast)", mon.Exp)
+	}
+	if mon.HP != mon.Stats.HP {
+		t.Errorf("HP = %d, want full HP %d", mon.HP, mon.Stats.HP)
+	}
+
+	if err := SetLevel(s, 1, 50); err == nil {
+		t.Error("SetLevel() on empty slot should fail")
+	}
+	if err := SetLevel(s, 0, 101); err == nil {
+		t.Error("SetLevel() above 100 should fail")
+	}
+}
+
+func TestSetMoves(t *testing.T) {
+	s := newPartySave(t)
+
+	// Keep Thunder Shock, replace Growl with Surf, add Thunderbolt
+	if err := SetMoves(s, 0, [4]byte{0x54, 0x39, 0x55, 0x00}); err != nil {
+		t.Fatalf("SetMoves() error = %v", err)
+	}
+
+	mon, _ := GetPartyMon(s, 0)
+	if mon.PP != [4]byte{30, 15, 15, 0} {
+		t.Errorf("PP = %v, want [30 15 15 0]", mon.PP)
+	}
+
+	invalid := [][4]byte{
+		{0x00, 0x54, 0x00, 0x00}, // empty first slot
+		{0x54, 0x54, 0x00, 0x00}, // duplicate
+		{0x54, 0x00, 0x55, 0x00}, // gap
+		{0x54, 0xA6, 0x00, 0x00}, // out of range
+	}
+	for _, m := range invalid {
+		if err := SetMoves(s, 0, m); err == nil {
+			t.Errorf("SetMoves(%v) should fail", m)
+		}
+	}
+}
+
+func TestSetDVs(t *testing.T) {
+	s := newPartySave(t)
+
+	if err := SetDVs(s, 0, DVs{15, 15, 15, 15}); err != nil {
+		t.Fatalf("SetDVs() error = %v", err)
+	}
+
+	mon, _ := GetPartyMon(s, 0)
+	if mon.DVs.HP() != 15 {
+		t.Errorf("HP DV = %d, want 15", mon.DVs.HP())
+	}
+	if mon.HP != mon.Stats.HP {
+		t.Errorf("HP = %d, want full HP %d", mon.HP, mon.Stats.HP)
+	}
+
+	if err := SetDVs(s, 0, DVs{16, 0, 0, 0}); err == nil {
+		t.Error("SetDVs() with DV 16 should fail")
+	}
+}
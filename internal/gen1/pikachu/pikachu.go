@@ -0,0 +1,80 @@
+package pikachu
+
+import (
+	"fmt"
+
+	"github.com/abravonunez/raracandy/internal/gen1/profile"
+	"github.com/abravonunez/raracandy/internal/gen1/save"
+)
+
+// MaxFriendship is the highest friendship the starter Pikachu can reach
+const MaxFriendship = 255
+
+// Mood is the friendship bucket that decides how the following Pikachu
+// reacts when the player talks to it
+type Mood int
+
+const (
+	MoodDislikes Mood = iota
+	MoodNeutral
+	MoodLikes
+	MoodHappy
+	MoodVeryHappy
+	MoodLoves
+)
+
+// moodThresholds holds the lowest friendship of each mood, in Mood order
+var moodThresholds = [...]byte{0, 51, 101, 131, 161, 201}
+
+func (m Mood) String() string {
+	switch m {
+	case MoodDislikes:
+		return "dislikes you"
+	case MoodNeutral:
+		return "neutral"
+	case MoodLikes:
+		return "likes you"
+	case MoodHappy:
+		return "happy"
+	case MoodVeryHappy:
+		return "very happy"
+	case MoodLoves:
+		return "loves you"
+	default:
+		return "unknown"
+	}
+}
+
+// MoodFor returns the mood bucket of a friendship value
+func MoodFor(friendship byte) Mood {
+	mood := MoodDislikes
+	for m, threshold := range moodThresholds {
+		if friendship >= threshold {
+			mood = Mood(m)
+		}
+	}
+	return mood
+}
+
+// Supported reports whether the save's game has a following Pikachu
+func Supported(s *save.Save) bool {
+	prof := s.GetProfile()
+	return prof.Version.Game() == profile.GameYellow && prof.OffsetPikachuFriendship != 0
+}
+
+// GetFriendship reads the starter Pikachu's friendship. Red/Blue saves are
+// refused: the byte means something else there.
+func GetFriendship(s *save.Save) (byte, error) {
+	if !Supported(s) {
+		return 0, fmt.Errorf("Pikachu friendship is only stored in Pokémon Yellow (detected %s)", s.GetProfile().Version)
+	}
+	return s.GetByte(s.GetProfile().OffsetPikachuFriendship), nil
+}
+
+// SetFriendship writes the starter Pikachu's friendship
+func SetFriendship(s *save.Save, friendship byte) error {
+	if !Supported(s) {
+		return fmt.Errorf("Pikachu friendship is only stored in Pokémon Yellow (detected %s)", s.GetProfile().Version)
+	}
+	return s.SetByte(s.GetProfile().OffsetPikachuFriendship, friendship)
+}
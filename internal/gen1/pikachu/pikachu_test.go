@@ -0,0 +1,62 @@
+package pikachu
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/abravonunez/raracandy/internal/gen1/profile"
+	"github.com/abravonunez/raracandy/internal/gen1/save"
+)
+
+func TestMoodFor(t *testing.T) {
+	tests := []struct {
+		friendship byte
+		want       Mood
+	}{
+		{0, MoodDislikes},
+		{50, MoodDislikes},
+		{51, MoodNeutral},
+		{100, MoodNeutral},
+		{101, MoodLikes},
+		{131, MoodHappy},
+		{200, MoodVeryHappy},
+		{201, MoodLoves},
+		{255, MoodLoves},
+	}
+
+	for _, tt := range tests {
+		if got := MoodFor(tt.friendship); got != tt.want {
+			t.Errorf("MoodFor(%d) = %s, want %s", tt.friendship, got, tt.want)
+		}
+	}
+}
+
+func TestFriendship(t *testing.T) {
+	for _, prof := range []*profile.GameProfile{profile.ProfileYellowNA, profile.ProfileYellowJP} {
+		s := save.CreateTestSaveFor(prof)
+		if err := SetFriendship(s, 200); err != nil {
+			t.Fatalf("%s: SetFriendship() error: %v", prof.Name, err)
+		}
+		if got := s.GetByte(prof.OffsetPikachuFriendship); got != 200 {
+			t.Errorf("%s: friendship byte = %d, want 200", prof.Name, got)
+		}
+		if got, err := GetFriendship(s); err != nil || got != 200 {
+			t.Errorf("%s: GetFriendship() = %d, %v", prof.Name, got, err)
+		}
+	}
+}
+
+func TestRedBlueRefused(t *testing.T) {
+	s := save.CreateTestSaveFor(profile.ProfileRedBlueNA)
+	before := s.Data()
+
+	if _, err := GetFriendship(s); err == nil {
+		t.Error("GetFriendship() should refuse a Red/Blue save")
+	}
+	if err := SetFriendship(s, 100); err == nil {
+		t.Error("SetFriendship() should refuse a Red/Blue save")
+	}
+	if !bytes.Equal(before, s.Data()) {
+		t.Error("SetFriendship() modified a Red/Blue save")
+	}
+}
@@ -0,0 +1,153 @@
+package pokedex
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/abravonunez/raracandy/internal/gen1/save"
+	"github.com/abravonunez/raracandy/internal/gen1/species"
+)
+
+// Size is the length of each flag array: 151 bits rounded up to bytes
+const Size = 19
+
+// Bitset is a Pokédex flag array. Bit n-1 is set when National Dex number n
+// is flagged; the last bit is unused and left as found.
+type Bitset [Size]byte
+
+// Has reports whether a dex number is flagged (false when out of range)
+func (b Bitset) Has(dex int) bool {
+	if dex < 1 || dex > species.MaxDex {
+		return false
+	}
+	return b[(dex-1)/8]&(1<<((dex-1)%8)) != 0
+}
+
+// HasSpecies reports whether a species, given by internal index, is flagged
+func (b Bitset) HasSpecies(index byte) bool {
+	return b.Has(species.DexNumber(index))
+}
+
+// Set flags a dex number
+func (b *Bitset) Set(dex int) error {
+	if dex < 1 || dex > species.MaxDex {
+		return fmt.Errorf("dex number %d out of range (1-%d)", dex, species.MaxDex)
+	}
+	b[(dex-1)/8] |= 1 << ((dex - 1) % 8)
+	return nil
+}
+
+// Clear unflags a dex number
+func (b *Bitset) Clear(dex int) error {
+	if dex < 1 || dex > species.MaxDex {
+		return fmt.Errorf("dex number %d out of range (1-%d)", dex, species.MaxDex)
+	}
+	b[(dex-1)/8] &^= 1 << ((dex - 1) % 8)
+	return nil
+}
+
+// List returns the flagged dex numbers in ascending order
+func (b Bitset) List() []int {
+	dex := make([]int, 0)
+	for n := 1; n <= species.MaxDex; n++ {
+		if b.Has(n) {
+			dex = append(dex, n)
+		}
+	}
+	return dex
+}
+
+// Count returns the number of flagged dex numbers
+func (b Bitset) Count() int {
+	return len(b.List())
+}
+
+// GetOwned returns the "owned" (caught) flags
+func GetOwned(s *save.Save) Bitset {
+	return get(s, s.GetProfile().OffsetPokedexOwned)
+}
+
+// GetSeen returns the "seen" flags
+func GetSeen(s *save.Save) Bitset {
+	return get(s, s.GetProfile().OffsetPokedexSeen)
+}
+
+// SetOwned writes the "owned" flags as given
+func SetOwned(s *save.Save, b Bitset) error {
+	return set(s, s.GetProfile().OffsetPokedexOwned, b)
+}
+
+// SetSeen writes the "seen" flags as given
+func SetSeen(s *save.Save, b Bitset) error {
+	return set(s, s.GetProfile().OffsetPokedexSeen, b)
+}
+
+// MarkSeen flags dex numbers as seen
+func MarkSeen(s *save.Save, dex ...int) error {
+	seen := GetSeen(s)
+	for _, n := range dex {
+		if err := seen.Set(n); err != nil {
+			return err
+		}
+	}
+	return SetSeen(s, seen)
+}
+
+// MarkOwned flags dex numbers as owned, and therefore also as seen
+func MarkOwned(s *save.Save, dex ...int) error {
+	owned := GetOwned(s)
+	for _, n := range dex {
+		if err := owned.Set(n); err != nil {
+			return err
+		}
+	}
+	if err := SetOwned(s, owned); err != nil {
+		return err
+	}
+	return MarkSeen(s, dex...)
+}
+
+// Complete flags all 151 Pokémon as seen and owned
+func Complete(s *save.Save) error {
+	return MarkOwned(s, All()...)
+}
+
+// All returns every dex number
+func All() []int {
+	dex := make([]int, species.MaxDex)
+	for i := range dex {
+		dex[i] = i + 1
+	}
+	return dex
+}
+
+// Lookup resolves a National Dex number ("25") or a species name ("pikachu")
+func Lookup(arg string) (int, error) {
+	arg = strings.TrimSpace(arg)
+	if n, err := strconv.Atoi(arg); err == nil {
+		if _, err := species.IndexFromDex(n); err != nil {
+			return 0, err
+		}
+		return n, nil
+	}
+
+	index, err := species.GetIndex(arg)
+	if err != nil {
+		return 0, err
+	}
+	return species.DexNumber(index), nil
+}
+
+func get(s *save.Save, offset int) Bitset {
+	var b Bitset
+	copy(b[:], s.GetBytes(offset, Size))
+	return b
+}
+
+func set(s *save.Save, offset int, b Bitset) error {
+	if err := s.SetBytes(offset, b[:]); err != nil {
+		return fmt.Errorf("failed to write Pokédex flags: %w", err)
+	}
+	return nil
+}
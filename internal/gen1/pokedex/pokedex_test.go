@@ -0,0 +1,106 @@
+package pokedex
+
+import (
+	"testing"
+
+	"github.com/abravonunez/raracandy/internal/gen1/profile"
+	"github.com/abravonunez/raracandy/internal/gen1/save"
+)
+
+func TestBitset(t *testing.T) {
+	var b Bitset
+	for _, n := range []int{1, 8, 9, 151} {
+		if err := b.Set(n); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if b[0] != 0x81 || b[1] != 0x01 || b[18] != 0x40 {
+		t.Errorf("bytes = % X", b)
+	}
+	if !b.Has(151) || b.Has(150) || b.Has(0) || b.Has(152) {
+		t.Error("Has() returned wrong flags")
+	}
+	// Pikachu is internal index 0x54, dex #025
+	if b.HasSpecies(0x54) {
+		t.Error("HasSpecies(Pikachu) should be false")
+	}
+	b.Set(25)
+	if !b.HasSpecies(0x54) {
+		t.Error("HasSpecies(Pikachu) should be true")
+	}
+	if got := b.Count(); got != 5 {
+		t.Errorf("Count() = %d, want 5", got)
+	}
+	b.Clear(8)
+	if got := b.List(); len(got) != 4 || got[1] != 9 {
+		t.Errorf("List() = %v", got)
+	}
+	if err := b.Set(152); err == nil {
+		t.Error("Set(152) should fail")
+	}
+}
+
+func TestMarkOwnedImpliesSeen(t *testing.T) {
+	for _, prof := range []*profile.GameProfile{profile.ProfileYellowNA, profile.ProfileYellowJP} {
+		s := save.CreateTestSaveFor(prof)
+		if err := MarkSeen(s, 16); err != nil {
+			t.Fatal(err)
+		}
+		if err := MarkOwned(s, 25, 150); err != nil {
+			t.Fatal(err)
+		}
+
+		owned, seen := GetOwned(s), GetSeen(s)
+		if owned.Count() != 2 || !owned.Has(25) || !owned.Has(150) {
+			t.Errorf("%s: owned = %v", prof.Name, owned.List())
+		}
+		if seen.Count() != 3 || !seen.Has(16) || !seen.Has(25) || !seen.Has(150) {
+			t.Errorf("%s: seen = %v", prof.Name, seen.List())
+		}
+		if got := s.GetByte(prof.OffsetPokedexOwned + 3); got != 0x01 {
+			t.Errorf("%s: owned byte 3 = 0x%02X, want 0x01", prof.Name, got)
+		}
+	}
+}
+
+func TestComplete(t *testing.T) {
+	s := save.CreateTestSave()
+	prof := s.GetProfile()
+	s.SetByte(prof.OffsetPokedexOwned+Size-1, 0x80) // unused bit
+
+	if err := Complete(s); err != nil {
+		t.Fatal(err)
+	}
+	if GetOwned(s).Count() != 151 || GetSeen(s).Count() != 151 {
+		t.Errorf("owned/seen = %d/%d, want 151", GetOwned(s).Count(), GetSeen(s).Count())
+	}
+	if got := s.GetByte(prof.OffsetPokedexOwned + Size - 1); got != 0xFF {
+		t.Errorf("last owned byte = 0x%02X, want 0xFF (unused bit kept)", got)
+	}
+	if got := s.GetByte(prof.OffsetPokedexSeen + Size - 1); got != 0x7F {
+		t.Errorf("last seen byte = 0x%02X, want 0x7F", got)
+	}
+}
+
+func TestLookup(t *testing.T) {
+	tests := []struct {
+		arg  string
+		want int
+	}{
+		{"25", 25},
+		{"pikachu", 25},
+		{"Mr. Mime", 122},
+		{"151", 151},
+	}
+	for _, tt := range tests {
+		got, err := Lookup(tt.arg)
+		if err != nil || got != tt.want {
+			t.Errorf("Lookup(%q) = %d, %v; want %d", tt.arg, got, err, tt.want)
+		}
+	}
+	for _, arg := range []string{"0", "152", "agumon"} {
+		if _, err := Lookup(arg); err == nil {
+			t.Errorf("Lookup(%q) should fail", arg)
+		}
+	}
+}
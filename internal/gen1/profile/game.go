@@ -0,0 +1,81 @@
+package profile
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Game identifies which of the Gen 1 games a save belongs to, independently
+// of the region. Red/Blue (and Green in Japan) share everything but a few
+// Yellow-only fields, so they are treated as one game.
+type Game int
+
+const (
+	GameAuto Game = iota // detect from the save
+	GameYellow
+	GameRedBlue
+)
+
+func (g Game) String() string {
+	switch g {
+	case GameYellow:
+		return "yellow"
+	case GameRedBlue:
+		return "redblue"
+	default:
+		return "auto"
+	}
+}
+
+// ParseGame parses a --game value (auto, yellow, redblue)
+func ParseGame(s string) (Game, error) {
+	switch strings.ToLower(s) {
+	case "auto", "":
+		return GameAuto, nil
+	case "yellow":
+		return GameYellow, nil
+	case "redblue", "red", "blue", "green":
+		return GameRedBlue, nil
+	default:
+		return GameAuto, fmt.Errorf("unknown game %q (expected auto, yellow or redblue)", s)
+	}
+}
+
+// Game returns which game a version belongs to
+func (v GameVersion) Game() Game {
+	switch v {
+	case VersionYellowNA, VersionYellowJP, VersionYellowEU:
+		return GameYellow
+	case VersionRedBlueNA, VersionRedGreenBlueJP, VersionRedBlueEU:
+		return GameRedBlue
+	default:
+		return GameAuto
+	}
+}
+
+// WithGame returns the version of the given game in the same region family
+// as v. GameAuto and VersionUnknown return v unchanged.
+func (v GameVersion) WithGame(g Game) GameVersion {
+	if g == GameAuto || v == VersionUnknown {
+		return v
+	}
+
+	yellow := g == GameYellow
+	switch v {
+	case VersionYellowJP, VersionRedGreenBlueJP:
+		if yellow {
+			return VersionYellowJP
+		}
+		return VersionRedGreenBlueJP
+	case VersionYellowEU, VersionRedBlueEU:
+		if yellow {
+			return VersionYellowEU
+		}
+		return VersionRedBlueEU
+	default:
+		if yellow {
+			return VersionYellowNA
+		}
+		return VersionRedBlueNA
+	}
+}
@@ -0,0 +1,24 @@
+package profile
+
+// Options byte layout, the same in every game: bits 0-3 text delay in
+// frames, bits 4-5 sound (Yellow only, 0 on Red/Blue), bit 6 battle style
+// set, bit 7 battle animations off
+const (
+	OptionsTextDelayMask  = 0x0F
+	OptionsSoundMask      = 0x30
+	OptionsSoundShift     = 4
+	OptionsBattleStyleBit = 0x40
+	OptionsAnimationsBit  = 0x80
+)
+
+// Text delays the options menu offers
+const (
+	TextDelayFast   = 1
+	TextDelayMedium = 3
+	TextDelaySlow   = 5
+)
+
+// ValidTextDelay reports whether the options menu can select a text delay
+func ValidTextDelay(delay byte) bool {
+	return delay == TextDelayFast || delay == TextDelayMedium || delay == TextDelaySlow
+}
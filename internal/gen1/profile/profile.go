@@ -1,5 +1,13 @@
 package profile
 
+import "github.com/abravonunez/raracandy/internal/gen1/text"
+
+const (
+	bankSize    = 0x2000
+	boxBankBase = 0x4000 // SRAM bank 2
+	boxMonSize  = 33
+)
+
 // GameVersion represents the detected game version
 type GameVersion int
 
@@ -9,6 +17,8 @@ const (
 	VersionYellowJP
 	VersionYellowEU
 	VersionRedBlueNA
+	VersionRedGreenBlueJP
+	VersionRedBlueEU
 )
 
 func (v GameVersion) String() string {
@@ -21,6 +31,10 @@ func (v GameVersion) String() string {
 		return "Pokémon Yellow (Europe)"
 	case VersionRedBlueNA:
 		return "Pokémon Red/Blue (North America)"
+	case VersionRedGreenBlueJP:
+		return "Pokémon Red/Green/Blue (Japan)"
+	case VersionRedBlueEU:
+		return "Pokémon Red/Blue (Europe)"
 	default:
 		return "Unknown"
 	}
@@ -29,6 +43,7 @@ func (v GameVersion) String() string {
 // GameProfile encapsulates all version-specific configurations and offsets
 type GameProfile struct {
 	Version        GameVersion
+	Region         Region
 	Name           string
 	OffsetChecksum int
 	ChecksumStart  int
@@ -36,14 +51,72 @@ type GameProfile struct {
 	OffsetBagCount int
 	OffsetBagItems int
 	OffsetMoney    int
+	OffsetCoins    int
 	MaxBagItems    int
 	MaxMoney       uint32
+	OffsetParty    int
+	MaxPartyMons   int
+	NameLength     int
+	Charset        text.Charset
+
+	OffsetPlayerName int
+	OffsetRivalName  int
+	OffsetPlayerID   int
+
+	// Pokédex flag arrays (one bit per National Dex number), options byte,
+	// badge bits and play time (hours, maxed flag, minutes, seconds, frames)
+	OffsetPokedexOwned int
+	OffsetPokedexSeen  int
+	OffsetOptions      int
+	OffsetBadges       int
+	OffsetPlayTime     int
+
+	// Event flags (one bit per event, e.g. gym leaders defeated), the
+	// hide/show flags of overworld sprites (right after the coins), and the
+	// flags of hidden items and Game Corner coins already picked up
+	OffsetEventFlags      int
+	OffsetMissableObjects int
+	OffsetHiddenItems     int
+	OffsetHiddenCoins     int
+
+	// Starter species chosen by the player and the rival (0 before Oak's lab).
+	// OffsetPikachuFriendship and OffsetPikachuFollowing (bit 7 set while
+	// Pikachu walks behind the player) are 0 for games without a following
+	// Pikachu.
+	OffsetPlayerStarter     int
+	OffsetRivalStarter      int
+	OffsetPikachuFriendship int
+	OffsetPikachuFollowing  int
+
+	// Map the player is on
+	OffsetCurrentMap int
+
+	// Daycare: in-use flag, nickname, OT name and a 33-byte box structure
+	OffsetDaycare int
+
+	// Hall of Fame: number of teams inducted (main data) and the team
+	// records in SRAM bank 0, which no checksum covers
+	OffsetHallOfFameCount int
+	OffsetHallOfFame      int
+
+	OffsetPCItemCount int
+	OffsetPCItems     int
+	MaxPCItems        int
+
+	// PC box storage: the current box lives in bank 1 at OffsetCurrentBox,
+	// every box also has a slot in bank 2 or 3 with its own checksum
+	OffsetCurrentBoxNum int
+	OffsetCurrentBox    int
+	BoxCount            int
+	BoxesPerBank        int
+	MaxBoxMons          int
 }
 
 var (
 	// ProfileYellowNA defines offsets and config for Pokémon Yellow (North America)
 	ProfileYellowNA = &GameProfile{
 		Version:        VersionYellowNA,
+		Region:         RegionNA,
 		Name:           "Pokémon Yellow (North America)",
 		OffsetChecksum: 0x3523,
 		ChecksumStart:  0x2598,
@@ -51,13 +124,54 @@ var (
 		OffsetBagCount: 0x25C9,
 		OffsetBagItems: 0x25CA,
 		OffsetMoney:    0x25F3,
+		OffsetCoins:    0x2850,
 		MaxBagItems:    20,
 		MaxMoney:       999999,
+		OffsetParty:    0x2F2C,
+		MaxPartyMons:   6,
+		NameLength:     11,
+		Charset:        text.English,
+
+		OffsetPlayerName: 0x2598,
+		OffsetRivalName:  0x25F6,
+		OffsetPlayerID:   0x2605,
+
+		OffsetPokedexOwned: 0x25A3,
+		OffsetPokedexSeen:  0x25B6,
+		OffsetOptions:      0x2601,
+		OffsetBadges:       0x2602,
+		OffsetPlayTime:     0x2CED,
+		OffsetDaycare:      0x2CF4,
+
+		OffsetEventFlags:      0x29F3,
+		OffsetMissableObjects: 0x2852,
+		OffsetHiddenItems:     0x299C,
+		OffsetHiddenCoins:     0x29AA,
+
+		OffsetPlayerStarter:     0x29C3,
+		OffsetRivalStarter:      0x29C1,
+		OffsetPikachuFriendship: 0x271C,
+		OffsetPikachuFollowing:  0x271F,
+
+		OffsetCurrentMap: 0x260A,
+
+		OffsetPCItemCount: 0x27E6,
+		OffsetPCItems:     0x27E7,
+		MaxPCItems:        50,
+
+		OffsetCurrentBoxNum:   0x284C,
+		OffsetHallOfFameCount: 0x284E,
+		OffsetHallOfFame:      0x0598,
+		OffsetCurrentBox:      0x30C0,
+		BoxCount:              12,
+		BoxesPerBank:          6,
+		MaxBoxMons:            20,
 	}
 
 	// ProfileRedBlueNA defines offsets and config for Pokémon Red/Blue (North America)
 	ProfileRedBlueNA = &GameProfile{
 		Version:        VersionRedBlueNA,
+		Region:         RegionNA,
 		Name:           "Pokémon Red/Blue (North America)",
 		OffsetChecksum: 0x3523,
 		ChecksumStart:  0x2598,
@@ -65,11 +179,185 @@ var (
 		OffsetBagCount: 0x25C9,
 		OffsetBagItems: 0x25CA,
 		OffsetMoney:    0x25F3,
+		OffsetCoins:    0x2850,
 		MaxBagItems:    20,
 		MaxMoney:       999999,
+		OffsetParty:    0x2F2C,
+		MaxPartyMons:   6,
+		NameLength:     11,
+		Charset:        text.English,
+
+		OffsetPlayerName: 0x2598,
+		OffsetRivalName:  0x25F6,
+		OffsetPlayerID:   0x2605,
+
+		OffsetPokedexOwned: 0x25A3,
+		OffsetPokedexSeen:  0x25B6,
+		OffsetOptions:      0x2601,
+		OffsetBadges:       0x2602,
+		OffsetPlayTime:     0x2CED,
+		OffsetDaycare:      0x2CF4,
+
+		OffsetEventFlags:      0x29F3,
+		OffsetMissableObjects: 0x2852,
+		OffsetHiddenItems:     0x299C,
+		OffsetHiddenCoins:     0x29AA,
+
+		OffsetPlayerStarter:     0x29C3,
+		OffsetRivalStarter:      0x29C1,
+		OffsetPikachuFriendship: 0,
+		OffsetPikachuFollowing:  0,
+
+		OffsetCurrentMap: 0x260A,
+
+		OffsetPCItemCount: 0x27E6,
+		OffsetPCItems:     0x27E7,
+		MaxPCItems:        50,
+
+		OffsetCurrentBoxNum:   0x284C,
+		OffsetHallOfFameCount: 0x284E,
+		OffsetHallOfFame:      0x0598,
+		OffsetCurrentBox:      0x30C0,
+		BoxCount:              12,
+		BoxesPerBank:          6,
+		MaxBoxMons:            20,
+	}
+
+	// ProfileYellowJP defines offsets and config for Pokémon Yellow (Japan).
+	// Names are 6 bytes (5 characters), which shifts everything after the
+	// player name; boxes hold 30 Pokémon, 4 boxes per bank.
+	ProfileYellowJP = &GameProfile{
+		Version:        VersionYellowJP,
+		Region:         RegionJP,
+		Name:           "Pokémon Yellow (Japan)",
+		OffsetChecksum: 0x3594,
+		ChecksumStart:  0x2598,
+		ChecksumEnd:    0x3593,
+		OffsetBagCount: 0x25C4,
+		OffsetBagItems: 0x25C5,
+		OffsetMoney:    0x25EE,
+		OffsetCoins:    0x2846,
+		MaxBagItems:    20,
+		MaxMoney:       999999,
+		OffsetParty:    0x2ED5,
+		MaxPartyMons:   6,
+		NameLength:     6,
+		Charset:        text.Japanese,
+
+		OffsetPlayerName: 0x2598,
+		OffsetRivalName:  0x25F1,
+		OffsetPlayerID:   0x25FB,
+
+		OffsetPokedexOwned: 0x259E,
+		OffsetPokedexSeen:  0x25B1,
+		OffsetOptions:      0x25F7,
+		OffsetBadges:       0x25F8,
+		OffsetPlayTime:     0x2CA0,
+		OffsetDaycare:      0x2CA7,
+
+		OffsetEventFlags:      0x29E9,
+		OffsetMissableObjects: 0x2848,
+		OffsetHiddenItems:     0x2992,
+		OffsetHiddenCoins:     0x29A0,
+
+		OffsetPlayerStarter:     0x29B9,
+		OffsetRivalStarter:      0x29B7,
+		OffsetPikachuFriendship: 0x2712,
+		OffsetPikachuFollowing:  0x2715,
+
+		OffsetCurrentMap: 0x2600,
+
+		OffsetPCItemCount: 0x27DC,
+		OffsetPCItems:     0x27DD,
+		MaxPCItems:        50,
+
+		OffsetCurrentBoxNum:   0x2842,
+		OffsetHallOfFameCount: 0x2844,
+		OffsetHallOfFame:      0x0598,
+		OffsetCurrentBox:      0x302D,
+		BoxCount:              8,
+		BoxesPerBank:          4,
+		MaxBoxMons:            30,
+	}
+
+	// ProfileRedGreenBlueJP defines offsets and config for Pokémon Red/Green/Blue (Japan)
+	ProfileRedGreenBlueJP = &GameProfile{
+		Version:        VersionRedGreenBlueJP,
+		Region:         RegionJP,
+		Name:           "Pokémon Red/Green/Blue (Japan)",
+		OffsetChecksum: 0x3594,
+		ChecksumStart:  0x2598,
+		ChecksumEnd:    0x3593,
+		OffsetBagCount: 0x25C4,
+		OffsetBagItems: 0x25C5,
+		OffsetMoney:    0x25EE,
+		OffsetCoins:    0x2846,
+		MaxBagItems:    20,
+		MaxMoney:       999999,
+		OffsetParty:    0x2ED5,
+		MaxPartyMons:   6,
+		NameLength:     6,
+		Charset:        text.Japanese,
+
+		OffsetPlayerName: 0x2598,
+		OffsetRivalName:  0x25F1,
+		OffsetPlayerID:   0x25FB,
+
+		OffsetPokedexOwned: 0x259E,
+		OffsetPokedexSeen:  0x25B1,
+		OffsetOptions:      0x25F7,
+		OffsetBadges:       0x25F8,
+		OffsetPlayTime:     0x2CA0,
+		OffsetDaycare:      0x2CA7,
+
+		OffsetEventFlags:      0x29E9,
+		OffsetMissableObjects: 0x2848,
+		OffsetHiddenItems:     0x2992,
+		OffsetHiddenCoins:     0x29A0,
+
+		OffsetPlayerStarter:     0x29B9,
+		OffsetRivalStarter:      0x29B7,
+		OffsetPikachuFriendship: 0,
+		OffsetPikachuFollowing:  0,
+
+		OffsetCurrentMap: 0x2600,
+
+		OffsetPCItemCount: 0x27DC,
+		OffsetPCItems:     0x27DD,
+		MaxPCItems:        50,
+
+		OffsetCurrentBoxNum:   0x2842,
+		OffsetHallOfFameCount: 0x2844,
+		OffsetHallOfFame:      0x0598,
+		OffsetCurrentBox:      0x302D,
+		BoxCount:              8,
+		BoxesPerBank:          4,
+		MaxBoxMons:            30,
 	}
 )
 
+// BoxSize returns the size of one box: count, species list, structs, OT names and nicknames
+func (p *GameProfile) BoxSize() int {
+	return 1 + (p.MaxBoxMons + 1) + p.MaxBoxMons*(boxMonSize+2*p.NameLength)
+}
+
+// BoxBankStart returns the offset of a box bank (0 = SRAM bank 2, 1 = SRAM bank 3)
+func (p *GameProfile) BoxBankStart(bank int) int {
+	return boxBankBase + bank*bankSize
+}
+
+// BoxOffset returns the offset of a box's slot in banks 2/3 (box is 0-based)
+func (p *GameProfile) BoxOffset(box int) int {
+	bank := box / p.BoxesPerBank
+	return p.BoxBankStart(bank) + (box%p.BoxesPerBank)*p.BoxSize()
+}
+
+// BankChecksumOffset returns the offset of a box bank's whole-bank checksum.
+// The per-box checksums follow it, one byte per box.
+func (p *GameProfile) BankChecksumOffset(bank int) int {
+	return p.BoxBankStart(bank) + p.BoxesPerBank*p.BoxSize()
+}
+
 // GetProfile returns the appropriate GameProfile for a given game version
 func GetProfile(version GameVersion) *GameProfile {
 	switch version {
@@ -77,6 +365,15 @@ func GetProfile(version GameVersion) *GameProfile {
 		return ProfileYellowNA
 	case VersionRedBlueNA:
 		return ProfileRedBlueNA
+	case VersionYellowJP:
+		return ProfileYellowJP
+	case VersionRedGreenBlueJP:
+		return ProfileRedGreenBlueJP
+	// PAL releases differ only by language; use ForRegion to pick one
+	case VersionYellowEU:
+		return ProfileYellowFR
+	case VersionRedBlueEU:
+		return ProfileRedBlueFR
 	default:
 		// Fallback to Yellow NA as a conservative default
 		return ProfileYellowNA
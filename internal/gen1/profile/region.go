@@ -0,0 +1,117 @@
+package profile
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/abravonunez/raracandy/internal/gen1/text"
+)
+
+// Region identifies a cartridge release. NA and the PAL releases share one
+// save layout and differ only in their character tables; JP has its own layout.
+type Region int
+
+const (
+	RegionAuto Region = iota // detect from the save
+	RegionNA
+	RegionJP
+	RegionFR
+	RegionDE
+	RegionIT
+	RegionES
+)
+
+func (r Region) String() string {
+	switch r {
+	case RegionNA:
+		return "na"
+	case RegionJP:
+		return "jp"
+	case RegionFR:
+		return "fr"
+	case RegionDE:
+		return "de"
+	case RegionIT:
+		return "it"
+	case RegionES:
+		return "es"
+	default:
+		return "auto"
+	}
+}
+
+// IsEuropean reports whether the region is one of the PAL releases
+func (r Region) IsEuropean() bool {
+	return r >= RegionFR && r <= RegionES
+}
+
+// ParseRegion parses a --region value (auto, na, jp, fr, de, it, es)
+func ParseRegion(s string) (Region, error) {
+	for r := RegionAuto; r <= RegionES; r++ {
+		if strings.EqualFold(s, r.String()) {
+			return r, nil
+		}
+	}
+	return RegionAuto, fmt.Errorf("unknown region %q (expected auto, na, jp, fr, de, it or es)", s)
+}
+
+var (
+	ProfileYellowFR = localize(ProfileYellowNA, VersionYellowEU, RegionFR, "Pokémon Yellow (France)", text.French)
+	ProfileYellowDE = localize(ProfileYellowNA, VersionYellowEU, RegionDE, "Pokémon Yellow (Germany)", text.German)
+	ProfileYellowIT = localize(ProfileYellowNA, VersionYellowEU, RegionIT, "Pokémon Yellow (Italy)", text.Italian)
+	ProfileYellowES = localize(ProfileYellowNA, VersionYellowEU, RegionES, "Pokémon Yellow (Spain)", text.Spanish)
+
+	ProfileRedBlueFR = localize(ProfileRedBlueNA, VersionRedBlueEU, RegionFR, "Pokémon Red/Blue (France)", text.French)
+	ProfileRedBlueDE = localize(ProfileRedBlueNA, VersionRedBlueEU, RegionDE, "Pokémon Red/Blue (Germany)", text.German)
+	ProfileRedBlueIT = localize(ProfileRedBlueNA, VersionRedBlueEU, RegionIT, "Pokémon Red/Blue (Italy)", text.Italian)
+	ProfileRedBlueES = localize(ProfileRedBlueNA, VersionRedBlueEU, RegionES, "Pokémon Red/Blue (Spain)", text.Spanish)
+)
+
+// localize derives a PAL profile from the NA one: the offsets are identical,
+// only the version, region, name and character table change
+func localize(base *GameProfile, version GameVersion, region Region, name string, charset text.Charset) *GameProfile {
+	p := *base
+	p.Version = version
+	p.Region = region
+	p.Name = name
+	p.Charset = charset
+	return &p
+}
+
+// ForRegion returns the profile for a game version in a given region. With
+// RegionAuto it behaves like GetProfile. A region overrides the layout
+// implied by the version, so forcing "jp" on an NA-detected save selects
+// the JP layout.
+func ForRegion(version GameVersion, region Region) *GameProfile {
+	redBlue := version == VersionRedBlueNA || version == VersionRedBlueEU || version == VersionRedGreenBlueJP
+
+	switch region {
+	case RegionNA:
+		if redBlue {
+			return ProfileRedBlueNA
+		}
+		return ProfileYellowNA
+	case RegionJP:
+		if redBlue {
+			return ProfileRedGreenBlueJP
+		}
+		return ProfileYellowJP
+	case RegionFR:
+		return pick(redBlue, ProfileRedBlueFR, ProfileYellowFR)
+	case RegionDE:
+		return pick(redBlue, ProfileRedBlueDE, ProfileYellowDE)
+	case RegionIT:
+		return pick(redBlue, ProfileRedBlueIT, ProfileYellowIT)
+	case RegionES:
+		return pick(redBlue, ProfileRedBlueES, ProfileYellowES)
+	default:
+		return GetProfile(version)
+	}
+}
+
+func pick(redBlue bool, rb, yellow *GameProfile) *GameProfile {
+	if redBlue {
+		return rb
+	}
+	return yellow
+}
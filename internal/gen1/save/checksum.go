@@ -14,11 +14,16 @@ func (s *Save) CalculateChecksum() byte {
 	return ^sum
 }
 
-// RecalculateChecksum updates the checksum in the save data
+// RecalculateChecksum updates the checksum in the save data.
+// Box bank checksums are updated too once the boxes have been initialized.
 func (s *Save) RecalculateChecksum() {
 	checksum := s.CalculateChecksum()
 	profile := s.GetProfile()
 	s.SetByte(profile.OffsetChecksum, checksum)
+
+	if s.BoxesInitialized() {
+		s.RecalculateBoxChecksums()
+	}
 }
 
 // GetChecksum returns the currently stored checksum
@@ -26,3 +31,69 @@ func (s *Save) GetChecksum() byte {
 	profile := s.GetProfile()
 	return s.GetByte(profile.OffsetChecksum)
 }
+
+// BoxesInitialized reports whether the PC boxes in banks 2/3 hold valid data.
+// The game only initializes them the first time the player changes boxes,
+// and records that in bit 7 of the current box number.
+func (s *Save) BoxesInitialized() bool {
+	profile := s.GetProfile()
+	return s.GetByte(profile.OffsetCurrentBoxNum)&0x80 != 0
+}
+
+// BoxBankCount returns the number of SRAM banks used for PC boxes
+func (s *Save) BoxBankCount() int {
+	profile := s.GetProfile()
+	return profile.BoxCount / profile.BoxesPerBank
+}
+
+// CalculateBankChecksum computes the whole-bank checksum of a box bank
+// (0 = SRAM bank 2, 1 = SRAM bank 3) over every box it holds
+func (s *Save) CalculateBankChecksum(bank int) byte {
+	profile := s.GetProfile()
+	start := profile.BoxBankStart(bank)
+	end := profile.BankChecksumOffset(bank)
+	return s.checksumRange(start, end-1)
+}
+
+// CalculateBoxChecksum computes the checksum of a single box slot (0-based)
+func (s *Save) CalculateBoxChecksum(box int) byte {
+	profile := s.GetProfile()
+	start := profile.BoxOffset(box)
+	return s.checksumRange(start, start+profile.BoxSize()-1)
+}
+
+// GetBankChecksum returns the stored whole-bank checksum of a box bank
+func (s *Save) GetBankChecksum(bank int) byte {
+	return s.GetByte(s.GetProfile().BankChecksumOffset(bank))
+}
+
+// GetBoxChecksum returns the stored checksum of a single box slot (0-based)
+func (s *Save) GetBoxChecksum(box int) byte {
+	return s.GetByte(s.boxChecksumOffset(box))
+}
+
+// RecalculateBoxChecksums updates the whole-bank and per-box checksums of both box banks
+func (s *Save) RecalculateBoxChecksums() {
+	profile := s.GetProfile()
+	for box := 0; box < profile.BoxCount; box++ {
+		s.SetByte(s.boxChecksumOffset(box), s.CalculateBoxChecksum(box))
+	}
+	for bank := 0; bank < s.BoxBankCount(); bank++ {
+		s.SetByte(profile.BankChecksumOffset(bank), s.CalculateBankChecksum(bank))
+	}
+}
+
+func (s *Save) boxChecksumOffset(box int) int {
+	profile := s.GetProfile()
+	bank := box / profile.BoxesPerBank
+	return profile.BankChecksumOffset(bank) + 1 + box%profile.BoxesPerBank
+}
+
+// checksumRange sums the bytes from start to end (inclusive) and applies bitwise NOT
+func (s *Save) checksumRange(start, end int) byte {
+	var sum byte = 0
+	for i := start; i <= end; i++ {
+		sum += s.GetByte(i)
+	}
+	return ^sum
+}
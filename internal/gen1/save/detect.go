@@ -0,0 +1,108 @@
+package save
+
+import (
+	"fmt"
+
+	"github.com/abravonunez/raracandy/internal/gen1/profile"
+	"github.com/abravonunez/raracandy/internal/gen1/species"
+)
+
+// Internal species indexes of the starters
+const (
+	indexPikachu    = 0x54
+	indexEevee      = 0x66
+	indexBulbasaur  = 0x99
+	indexCharmander = 0xB0
+	indexSquirtle   = 0xB1
+)
+
+// mapSummerBeachHouse is the Yellow-only map where the Pikachu surfing
+// minigame is played; Red/Blue have no map with this number
+const mapSummerBeachHouse = 0xF8
+
+// pikachuFollowingBit is set in the Pikachu-follows byte while Pikachu walks
+// behind the player
+const pikachuFollowingBit = 0x80
+
+// Evidence weights: the player's starter is the strongest signal since
+// Yellow always gives Pikachu, and standing on a Yellow-only map is as
+// strong; the rival's starter only supports it, and so do the Pikachu bytes,
+// which Red/Blue leave unused
+const (
+	weightPlayerStarter  = 3
+	weightYellowMap      = 3
+	weightRivalStarter   = 2
+	weightFriendship     = 1
+	weightPikachuFollows = 1
+)
+
+// GameDetection is the outcome of telling Yellow apart from Red/Blue
+type GameDetection struct {
+	Game       profile.Game
+	Confidence float64 // share of the evidence supporting Game, 0.5 when there is none
+	Evidence   []string
+}
+
+// DetectGame uses Yellow-only data (starter species, rival's Eevee,
+// Pikachu's friendship and follow flag, Yellow-only maps) to decide between
+// Yellow and Red/Blue. Saves with no evidence either way (e.g. before Oak's
+// lab) default to Yellow.
+func (s *Save) DetectGame() GameDetection {
+	// Red/Blue share the Yellow layout; only the Yellow profile knows where
+	// Pikachu's bytes live
+	layout := profile.ProfileYellowNA
+	if s.DetectRegion() == profile.RegionJP {
+		layout = profile.ProfileYellowJP
+	}
+
+	var yellow, redBlue int
+	evidence := make([]string, 0, 5)
+
+	switch starter := s.GetByte(layout.OffsetPlayerStarter); starter {
+	case 0:
+		evidence = append(evidence, "no starter chosen yet")
+	case indexPikachu:
+		yellow += weightPlayerStarter
+		evidence = append(evidence, "player's starter is Pikachu (Yellow)")
+	case indexBulbasaur, indexCharmander, indexSquirtle:
+		redBlue += weightPlayerStarter
+		evidence = append(evidence, fmt.Sprintf("player's starter is %s (Red/Blue)", species.GetName(starter)))
+	default:
+		evidence = append(evidence, fmt.Sprintf("player's starter byte 0x%02X is not a starter", starter))
+	}
+
+	switch starter := s.GetByte(layout.OffsetRivalStarter); starter {
+	case indexEevee:
+		yellow += weightRivalStarter
+		evidence = append(evidence, "rival's starter is Eevee (Yellow)")
+	case indexBulbasaur, indexCharmander, indexSquirtle:
+		redBlue += weightRivalStarter
+		evidence = append(evidence, fmt.Sprintf("rival's starter is %s (Red/Blue)", species.GetName(starter)))
+	}
+
+	if friendship := s.GetByte(layout.OffsetPikachuFriendship); friendship != 0 {
+		yellow += weightFriendship
+		evidence = append(evidence, fmt.Sprintf("Pikachu friendship byte is %d (Yellow)", friendship))
+	}
+
+	if s.GetByte(layout.OffsetPikachuFollowing)&pikachuFollowingBit != 0 {
+		yellow += weightPikachuFollows
+		evidence = append(evidence, "Pikachu is following the player (Yellow)")
+	}
+
+	if s.GetByte(layout.OffsetCurrentMap) == mapSummerBeachHouse {
+		yellow += weightYellowMap
+		evidence = append(evidence, "player is in the Summer Beach House, a Yellow-only map")
+	}
+
+	if yellow+redBlue == 0 {
+		evidence = append(evidence, "no Yellow- or Red/Blue-specific data found, assuming Yellow")
+		return GameDetection{Game: profile.GameYellow, Confidence: 0.5, Evidence: evidence}
+	}
+
+	total := float64(yellow + redBlue)
+	if redBlue > yellow {
+		return GameDetection{Game: profile.GameRedBlue, Confidence: float64(redBlue) / total, Evidence: evidence}
+	}
+	return GameDetection{Game: profile.GameYellow, Confidence: float64(yellow) / total, Evidence: evidence}
+}
@@ -0,0 +1,113 @@
+package save
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/abravonunez/raracandy/internal/gen1/profile"
+)
+
+func TestDetectGame(t *testing.T) {
+	tests := []struct {
+		name       string
+		prof       *profile.GameProfile
+		player     byte
+		rival      byte
+		friendship byte
+		want       profile.GameVersion
+		confidence float64
+	}{
+		{"yellow", profile.ProfileYellowNA, indexPikachu, indexEevee, 90, profile.VersionYellowNA, 1},
+		{"red/blue", profile.ProfileYellowNA, indexCharmander, indexSquirtle, 0, profile.VersionRedBlueNA, 1},
+		{"new game", profile.ProfileYellowNA, 0, 0, 0, profile.VersionYellowNA, 0.5},
+		{"mixed", profile.ProfileYellowNA, indexBulbasaur, indexCharmander, 12, profile.VersionRedBlueNA, 5.0 / 6},
+		{"jp red/green", profile.ProfileYellowJP, indexSquirtle, indexBulbasaur, 0, profile.VersionRedGreenBlueJP, 1},
+	}
+
+	for _, tt := range tests {
+		s := CreateTestSaveFor(tt.prof)
+		s.SetByte(tt.prof.OffsetPlayerStarter, tt.player)
+		s.SetByte(tt.prof.OffsetRivalStarter, tt.rival)
+		s.SetByte(tt.prof.OffsetPikachuFriendship, tt.friendship)
+		s.RecalculateChecksum()
+
+		if got := s.DetectGameVersion(); got != tt.want {
+			t.Errorf("%s: DetectGameVersion() = %v, want %v", tt.name, got, tt.want)
+		}
+		report := s.CheckIntegrity()
+		if report.Confidence != tt.confidence {
+			t.Errorf("%s: Confidence = %v, want %v", tt.name, report.Confidence, tt.confidence)
+		}
+		if len(report.Evidence) == 0 {
+			t.Errorf("%s: no evidence reported", tt.name)
+		}
+	}
+}
+
+func TestDetectGameYellowSignals(t *testing.T) {
+	for _, prof := range []*profile.GameProfile{profile.ProfileYellowNA, profile.ProfileYellowJP} {
+		// Each signal is weighed against a rival's Squirtle (Red/Blue, 2)
+		signals := []struct {
+			name       string
+			offset     int
+			value      byte
+			want       profile.Game
+			confidence float64
+			evidence   string
+		}{
+			{"pikachu follows", prof.OffsetPikachuFollowing, pikachuFollowingBit, profile.GameRedBlue, 2.0 / 3, "Pikachu is following the player (Yellow)"},
+			{"summer beach house", prof.OffsetCurrentMap, mapSummerBeachHouse, profile.GameYellow, 3.0 / 5, "player is in the Summer Beach House, a Yellow-only map"},
+		}
+		for _, sig := range signals {
+			s := CreateTestSaveFor(prof)
+			s.SetByte(prof.OffsetRivalStarter, indexSquirtle)
+			s.SetByte(sig.offset, sig.value)
+			s.RecalculateChecksum()
+
+			d := s.DetectGame()
+			if d.Game != sig.want || d.Confidence != sig.confidence {
+				t.Errorf("%s %s: DetectGame() = %v (%.2f), want %v (%.2f)", prof.Name, sig.name, d.Game, d.Confidence, sig.want, sig.confidence)
+			}
+			if got := d.Evidence[len(d.Evidence)-1]; got != sig.evidence {
+				t.Errorf("%s %s: evidence = %q, want %q", prof.Name, sig.name, got, sig.evidence)
+			}
+		}
+
+		// Other bits of the follow byte and other maps are not evidence
+		s := CreateTestSaveFor(prof)
+		s.SetByte(prof.OffsetPikachuFollowing, 0x7F)
+		s.SetByte(prof.OffsetCurrentMap, mapSummerBeachHouse-1)
+		s.RecalculateChecksum()
+		if d := s.DetectGame(); d.Confidence != 0.5 {
+			t.Errorf("%s: DetectGame() = %+v, want no evidence", prof.Name, d)
+		}
+	}
+}
+
+func TestLoadWithGame(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "rb.sav")
+	s := CreateTestSave()
+	s.SetByte(profile.ProfileYellowNA.OffsetPlayerStarter, indexSquirtle)
+	if err := s.Write(path); err != nil {
+		t.Fatal(err)
+	}
+
+	auto, err := Load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if auto.GetProfile() != profile.ProfileRedBlueNA {
+		t.Errorf("Load() profile = %s, want %s", auto.GetProfile().Name, profile.ProfileRedBlueNA.Name)
+	}
+
+	forced, err := LoadWith(path, LoadOptions{Game: profile.GameYellow})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if forced.GetProfile() != profile.ProfileYellowNA {
+		t.Errorf("LoadWith(yellow) profile = %s, want %s", forced.GetProfile().Name, profile.ProfileYellowNA.Name)
+	}
+	if report := forced.CheckIntegrity(); report.GameVersion != profile.VersionYellowNA || report.Confidence != 1 {
+		t.Errorf("CheckIntegrity() version = %v, confidence = %v", report.GameVersion, report.Confidence)
+	}
+}
@@ -0,0 +1,219 @@
+package save
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/abravonunez/raracandy/internal/gen1/profile"
+)
+
+// Event flag numbers, named as in the disassembly's event_constants
+const (
+	EventBeatBrock           = 0x077
+	EventBeatMisty           = 0x0BF
+	EventBeatLtSurge         = 0x167
+	EventBeatErika           = 0x1A9
+	EventBeatKoga            = 0x259
+	EventBeatBlaine          = 0x299
+	EventBeatSabrina         = 0x351
+	EventBeatGiovanni        = 0x051
+	EventFightRoute12Snorlax = 0x55C
+	EventBeatRoute12Snorlax  = 0x55D
+	EventFightRoute16Snorlax = 0x5E0
+	EventBeatRoute16Snorlax  = 0x5E1
+	EventBeatZapdos          = 0x7D4
+	EventBeatMewtwo          = 0x8C1
+	EventBeatMoltres         = 0x8EE
+	EventBeatArticuno        = 0x9DA
+)
+
+// MissableObject is an overworld sprite the game removes by setting its
+// hide/show flag, such as a legendary Pokémon once it has been battled. The
+// flag number (the disassembly's HS_* constant) differs between Red/Blue and
+// Yellow; -1 means it is not known for that game.
+type MissableObject struct {
+	Name    string
+	RedBlue int
+	Yellow  int
+}
+
+// Hide/show flags of the encounters the presets cover
+var (
+	HSRoute12Snorlax = MissableObject{"HS_ROUTE_12_SNORLAX", 0x1D, 0x1D}
+	HSRoute16Snorlax = MissableObject{"HS_ROUTE_16_SNORLAX", 0x21, 0x21}
+	HSZapdos         = MissableObject{"HS_ZAPDOS", 0x55, -1}
+	HSMoltres        = MissableObject{"HS_MOLTRES", 0x5B, -1}
+	HSMewtwo         = MissableObject{"HS_MEWTWO", 0xD1, -1}
+	HSArticuno       = MissableObject{"HS_ARTICUNO", 0xE3, -1}
+)
+
+// Flag returns the hide/show flag of the object in a game, or -1
+func (o MissableObject) Flag(g profile.Game) int {
+	switch g {
+	case profile.GameRedBlue:
+		return o.RedBlue
+	case profile.GameYellow:
+		return o.Yellow
+	default:
+		return -1
+	}
+}
+
+// NamedEventFlag is an event flag from the catalogue
+type NamedEventFlag struct {
+	Name        string
+	Flag        int
+	Description string
+}
+
+// EventCatalogue lists the story flags raracandy knows by name
+var EventCatalogue = []NamedEventFlag{
+	{"EVENT_BEAT_BROCK", EventBeatBrock, "Brock defeated (Pewter Gym)"},
+	{"EVENT_BEAT_MISTY", EventBeatMisty, "Misty defeated (Cerulean Gym)"},
+	{"EVENT_BEAT_LT_SURGE", EventBeatLtSurge, "Lt. Surge defeated (Vermilion Gym)"},
+	{"EVENT_BEAT_ERIKA", EventBeatErika, "Erika defeated (Celadon Gym)"},
+	{"EVENT_BEAT_KOGA", EventBeatKoga, "Koga defeated (Fuchsia Gym)"},
+	{"EVENT_BEAT_SABRINA", EventBeatSabrina, "Sabrina defeated (Saffron Gym)"},
+	{"EVENT_BEAT_BLAINE", EventBeatBlaine, "Blaine defeated (Cinnabar Gym)"},
+	{"EVENT_BEAT_VIRIDIAN_GYM_GIOVANNI", EventBeatGiovanni, "Giovanni defeated (Viridian Gym)"},
+	{"EVENT_FIGHT_ROUTE12_SNORLAX", EventFightRoute12Snorlax, "Route 12 Snorlax woken up, battle pending"},
+	{"EVENT_BEAT_ROUTE12_SNORLAX", EventBeatRoute12Snorlax, "Route 12 Snorlax battled"},
+	{"EVENT_FIGHT_ROUTE16_SNORLAX", EventFightRoute16Snorlax, "Route 16 Snorlax woken up, battle pending"},
+	{"EVENT_BEAT_ROUTE16_SNORLAX", EventBeatRoute16Snorlax, "Route 16 Snorlax battled"},
+	{"EVENT_BEAT_ZAPDOS", EventBeatZapdos, "Zapdos battled (Power Plant)"},
+	{"EVENT_BEAT_MOLTRES", EventBeatMoltres, "Moltres battled (Victory Road)"},
+	{"EVENT_BEAT_ARTICUNO", EventBeatArticuno, "Articuno battled (Seafoam Islands)"},
+	{"EVENT_BEAT_MEWTWO", EventBeatMewtwo, "Mewtwo battled (Cerulean Cave)"},
+}
+
+// EventPreset is a named group of catalogue flags edited together. Objects
+// are the overworld sprites the game hides once those events happen.
+type EventPreset struct {
+	Name        string
+	Description string
+	Flags       []int
+	Objects     []MissableObject
+}
+
+// EventPresets lists the presets accepted by the events commands. Clearing
+// an encounter preset also shows the Pokémon's sprite again, so the battle
+// can happen again; setting it hides the sprite.
+var EventPresets = []EventPreset{
+	{"gym-leaders", "All eight gym leaders", []int{EventBeatBrock, EventBeatMisty, EventBeatLtSurge, EventBeatErika, EventBeatKoga, EventBeatSabrina, EventBeatBlaine, EventBeatGiovanni}, nil},
+	{"snorlax", "Both Snorlax encounters (Routes 12 and 16)", []int{EventFightRoute12Snorlax, EventBeatRoute12Snorlax, EventFightRoute16Snorlax, EventBeatRoute16Snorlax}, []MissableObject{HSRoute12Snorlax, HSRoute16Snorlax}},
+	{"zapdos", "Zapdos encounter", []int{EventBeatZapdos}, []MissableObject{HSZapdos}},
+	{"moltres", "Moltres encounter", []int{EventBeatMoltres}, []MissableObject{HSMoltres}},
+	{"articuno", "Articuno encounter", []int{EventBeatArticuno}, []MissableObject{HSArticuno}},
+	{"legendary-birds", "Zapdos, Moltres and Articuno encounters", []int{EventBeatZapdos, EventBeatMoltres, EventBeatArticuno}, []MissableObject{HSZapdos, HSMoltres, HSArticuno}},
+	{"mewtwo", "Mewtwo encounter", []int{EventBeatMewtwo}, []MissableObject{HSMewtwo}},
+}
+
+// EventFlagName returns the catalogue name of a flag, or its number
+func EventFlagName(flag int) string {
+	for _, e := range EventCatalogue {
+		if e.Flag == flag {
+			return e.Name
+		}
+	}
+	return fmt.Sprintf("0x%03X", flag)
+}
+
+// LookupEventFlag resolves a catalogue name (the EVENT_ prefix is optional,
+// case is ignored) or a flag number such as 0x077
+func LookupEventFlag(arg string) (int, error) {
+	name := strings.ToUpper(strings.TrimSpace(arg))
+	for _, e := range EventCatalogue {
+		if name == e.Name || "EVENT_"+name == e.Name {
+			return e.Flag, nil
+		}
+	}
+
+	flag, err := strconv.ParseInt(name, 0, 32)
+	if err != nil {
+		return 0, fmt.Errorf("unknown event flag %q (use a catalogue name or a number such as 0x077)", arg)
+	}
+	if flag < 0 || flag >= MaxEventFlag {
+		return 0, fmt.Errorf("event flag 0x%03X out of range (0-0x%03X)", flag, MaxEventFlag-1)
+	}
+	return int(flag), nil
+}
+
+// LookupEventPreset finds a preset by name
+func LookupEventPreset(name string) (EventPreset, error) {
+	names := make([]string, 0, len(EventPresets))
+	for _, p := range EventPresets {
+		if strings.EqualFold(name, p.Name) {
+			return p, nil
+		}
+		names = append(names, p.Name)
+	}
+	sort.Strings(names)
+	return EventPreset{}, fmt.Errorf("unknown preset %q (available: %s)", name, strings.Join(names, ", "))
+}
+
+// ObjectHidden reports whether an object's sprite is hidden. ok is false
+// when its flag is not known for the save's game.
+func (s *Save) ObjectHidden(o MissableObject) (hidden, ok bool) {
+	flag := o.Flag(s.GetProfile().Version.Game())
+	if flag < 0 {
+		return false, false
+	}
+	return s.Flag(s.MissableObjectFlags(), flag), true
+}
+
+// SetObjectHidden hides or shows an object's sprite
+func (s *Save) SetObjectHidden(o MissableObject, hidden bool) error {
+	flag := o.Flag(s.GetProfile().Version.Game())
+	if flag < 0 {
+		return fmt.Errorf("%s is not known for %s", o.Name, s.GetProfile().Name)
+	}
+	return s.SetFlag(s.MissableObjectFlags(), flag, hidden)
+}
+
+// CheckPreset refuses a preset that cannot be applied in full to the save's
+// game because the hide/show flag of one of its objects is not known there;
+// editing only its event flags would leave the sprite out of step
+func (s *Save) CheckPreset(p EventPreset) error {
+	for _, o := range p.Objects {
+		if _, ok := s.ObjectHidden(o); !ok {
+			return fmt.Errorf("preset %s cannot be applied to %s: the hide/show flag %s is not known for this game (use --flag to edit the event flags alone)", p.Name, s.GetProfile().Name, o.Name)
+		}
+	}
+	return nil
+}
+
+// EventFlagsSet returns the number of every event flag that is set
+func (s *Save) EventFlagsSet() []int {
+	return s.FlagsSet(s.EventFlags())
+}
+
+// checkEventFlags warns about flag combinations the game cannot produce
+func (s *Save) checkEventFlags() []string {
+	warnings := make([]string, 0)
+
+	// The Viridian Gym only opens once the other seven badges are earned
+	if s.EventFlag(EventBeatGiovanni) {
+		for _, gym := range gymLeaders[:7] {
+			if !s.EventFlag(gym.flag) {
+				warnings = append(warnings, fmt.Sprintf("Giovanni is marked as defeated before %s", gym.leader))
+			}
+		}
+	}
+
+	// The fight flag is cleared as soon as the Snorlax battle ends
+	for _, snorlax := range []struct {
+		route       int
+		fight, beat int
+	}{
+		{12, EventFightRoute12Snorlax, EventBeatRoute12Snorlax},
+		{16, EventFightRoute16Snorlax, EventBeatRoute16Snorlax},
+	} {
+		if s.EventFlag(snorlax.fight) && s.EventFlag(snorlax.beat) {
+			warnings = append(warnings, fmt.Sprintf("Route %d Snorlax is both awaiting battle and already battled", snorlax.route))
+		}
+	}
+
+	return warnings
+}
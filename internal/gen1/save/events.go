@@ -0,0 +1,55 @@
+package save
+
+import "fmt"
+
+// MaxEventFlag is the number of event flags stored in the save
+const MaxEventFlag = 0xA00
+
+// gymLeaders lists, in badge bit order, the event flag set when each gym
+// leader is defeated
+var gymLeaders = [8]struct {
+	badge  string
+	leader string
+	flag   int
+}{
+	{"Boulder", "Brock", EventBeatBrock},
+	{"Cascade", "Misty", EventBeatMisty},
+	{"Thunder", "Lt. Surge", EventBeatLtSurge},
+	{"Rainbow", "Erika", EventBeatErika},
+	{"Soul", "Koga", EventBeatKoga},
+	{"Marsh", "Sabrina", EventBeatSabrina},
+	{"Volcano", "Blaine", EventBeatBlaine},
+	{"Earth", "Giovanni", EventBeatGiovanni},
+}
+
+// EventFlag reports whether an event flag is set
+func (s *Save) EventFlag(flag int) bool {
+	return s.Flag(s.EventFlags(), flag)
+}
+
+// SetEventFlag sets or clears an event flag
+func (s *Save) SetEventFlag(flag int, on bool) error {
+	if flag < 0 || flag >= MaxEventFlag {
+		return fmt.Errorf("event flag 0x%03X out of range (0-0x%03X)", flag, MaxEventFlag-1)
+	}
+	return s.SetFlag(s.EventFlags(), flag, on)
+}
+
+// checkBadgeFlags warns when a badge and its gym leader's defeat flag
+// disagree, which the game never produces on its own
+func (s *Save) checkBadgeFlags() []string {
+	badges := s.GetByte(s.GetProfile().OffsetBadges)
+	warnings := make([]string, 0)
+
+	for i, gym := range gymLeaders {
+		hasBadge := badges&(1<<i) != 0
+		beaten := s.EventFlag(gym.flag)
+		switch {
+		case hasBadge && !beaten:
+			warnings = append(warnings, fmt.Sprintf("%s Badge is set but %s's defeat flag is not", gym.badge, gym.leader))
+		case beaten && !hasBadge:
+			warnings = append(warnings, fmt.Sprintf("%s is marked as defeated but the %s Badge is missing", gym.leader, gym.badge))
+		}
+	}
+	return warnings
+}
@@ -0,0 +1,173 @@
+package save
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/abravonunez/raracandy/internal/gen1/profile"
+)
+
+func TestEventFlags(t *testing.T) {
+	s := CreateTestSave()
+	if err := s.SetEventFlag(0x0BF, true); err != nil {
+		t.Fatal(err)
+	}
+	if got := s.GetByte(s.GetProfile().OffsetEventFlags + 0x17); got != 0x80 {
+		t.Errorf("flag byte = 0x%02X, want 0x80", got)
+	}
+	if !s.EventFlag(0x0BF) || s.EventFlag(0x0BE) {
+		t.Error("EventFlag() returned wrong values")
+	}
+	s.SetEventFlag(0x0BF, false)
+	if s.EventFlag(0x0BF) {
+		t.Error("SetEventFlag(false) did not clear the flag")
+	}
+	if err := s.SetEventFlag(MaxEventFlag, true); err == nil {
+		t.Error("SetEventFlag() should reject out-of-range flags")
+	}
+}
+
+func TestBadgeFlagWarnings(t *testing.T) {
+	s := CreateTestSave()
+	prof := s.GetProfile()
+
+	// Boulder with Brock beaten is consistent; Cascade without Misty is not,
+	// and neither is Giovanni beaten without the Earth Badge
+	s.SetByte(prof.OffsetBadges, 0x03)
+	s.SetEventFlag(0x077, true)
+	s.SetEventFlag(0x051, true)
+	s.RecalculateChecksum()
+
+	report := s.CheckIntegrity()
+	if !report.IsValid {
+		t.Fatalf("badge warnings should not invalidate the save: %v", report.Errors)
+	}
+	warnings := strings.Join(report.Warnings, "\n")
+	if !strings.Contains(warnings, "Cascade Badge is set but Misty's defeat flag is not") {
+		t.Errorf("missing Cascade warning in %q", warnings)
+	}
+	if !strings.Contains(warnings, "Giovanni is marked as defeated but the Earth Badge is missing") {
+		t.Errorf("missing Earth warning in %q", warnings)
+	}
+	if strings.Contains(warnings, "Boulder") {
+		t.Errorf("unexpected Boulder warning in %q", warnings)
+	}
+}
+
+func TestLookupEventFlag(t *testing.T) {
+	tests := []struct {
+		arg  string
+		want int
+	}{
+		{"EVENT_BEAT_BROCK", EventBeatBrock},
+		{"beat_zapdos", EventBeatZapdos},
+		{"0x55D", EventBeatRoute12Snorlax},
+		{"12", 12},
+	}
+	for _, tt := range tests {
+		if got, err := LookupEventFlag(tt.arg); err != nil || got != tt.want {
+			t.Errorf("LookupEventFlag(%q) = 0x%03X, %v, want 0x%03X", tt.arg, got, err, tt.want)
+		}
+	}
+
+	for _, arg := range []string{"EVENT_BEAT_AGATHA_TWICE", "0xA00", "-1"} {
+		if _, err := LookupEventFlag(arg); err == nil {
+			t.Errorf("LookupEventFlag(%q) should fail", arg)
+		}
+	}
+
+	if p, err := LookupEventPreset("Legendary-Birds"); err != nil || len(p.Flags) != 3 {
+		t.Errorf("LookupEventPreset() = %+v, %v", p, err)
+	}
+	if _, err := LookupEventPreset("missingno"); err == nil {
+		t.Error("LookupEventPreset(missingno) should fail")
+	}
+}
+
+func TestEventFlagWarnings(t *testing.T) {
+	s := CreateTestSave()
+	s.SetEventFlag(EventBeatGiovanni, true)
+	for _, gym := range gymLeaders[:6] {
+		s.SetEventFlag(gym.flag, true)
+	}
+	s.SetEventFlag(EventFightRoute16Snorlax, true)
+	s.SetEventFlag(EventBeatRoute16Snorlax, true)
+	s.SetEventFlag(EventBeatRoute12Snorlax, true)
+
+	warnings := strings.Join(s.checkEventFlags(), "\n")
+	if !strings.Contains(warnings, "Giovanni is marked as defeated before Blaine") {
+		t.Errorf("missing Giovanni warning in %q", warnings)
+	}
+	if strings.Contains(warnings, "before Brock") {
+		t.Errorf("unexpected Brock warning in %q", warnings)
+	}
+	if !strings.Contains(warnings, "Route 16 Snorlax") || strings.Contains(warnings, "Route 12") {
+		t.Errorf("wrong Snorlax warnings in %q", warnings)
+	}
+}
+
+func TestPresetObjects(t *testing.T) {
+	for _, prof := range []*profile.GameProfile{profile.ProfileRedBlueNA, profile.ProfileRedGreenBlueJP} {
+		s := CreateTestSaveFor(prof)
+
+		// The hide/show flags follow the two coin bytes
+		if prof.OffsetMissableObjects != prof.OffsetCoins+2 {
+			t.Errorf("%s: hide/show flags at 0x%04X, want 0x%04X", prof.Name, prof.OffsetMissableObjects, prof.OffsetCoins+2)
+		}
+
+		p, err := LookupEventPreset("legendary-birds")
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, o := range p.Objects {
+			if err := s.SetObjectHidden(o, true); err != nil {
+				t.Fatal(err)
+			}
+		}
+		// HS_ZAPDOS is 0x55: bit 5 of byte 10
+		if got := s.GetByte(prof.OffsetMissableObjects + 10); got&0x20 == 0 {
+			t.Errorf("%s: HS_ZAPDOS byte = 0x%02X, want bit 5 set", prof.Name, got)
+		}
+		if got := s.FlagsSet(s.MissableObjectFlags()); len(got) != 3 {
+			t.Errorf("%s: hidden objects = %v, want 3", prof.Name, got)
+		}
+
+		// Clearing the preset shows every sprite again and leaves the coins alone
+		s.SetBytes(prof.OffsetCoins, []byte{0x99, 0x99})
+		for _, o := range p.Objects {
+			s.SetObjectHidden(o, false)
+			if hidden, ok := s.ObjectHidden(o); !ok || hidden {
+				t.Errorf("%s: %s still hidden", prof.Name, o.Name)
+			}
+		}
+		if got := s.GetBytes(prof.OffsetCoins, 2); got[0] != 0x99 || got[1] != 0x99 {
+			t.Errorf("%s: coins changed to % X", prof.Name, got)
+		}
+	}
+
+	// Objects whose Yellow flag is unknown are refused rather than guessed
+	s := CreateTestSave()
+	if _, ok := s.ObjectHidden(HSZapdos); ok {
+		t.Error("ObjectHidden(HS_ZAPDOS) should be unknown for Yellow")
+	}
+	if err := s.SetObjectHidden(HSZapdos, true); err == nil {
+		t.Error("SetObjectHidden(HS_ZAPDOS) should fail for Yellow")
+	}
+	if err := s.SetObjectHidden(HSRoute12Snorlax, true); err != nil || !s.Flag(s.MissableObjectFlags(), 0x1D) {
+		t.Errorf("SetObjectHidden(HS_ROUTE_12_SNORLAX) = %v", err)
+	}
+
+	// and so are the presets that contain them, before any flag is edited
+	for name, refused := range map[string]bool{"zapdos": true, "legendary-birds": true, "mewtwo": true, "snorlax": false, "gym-leaders": false} {
+		p, err := LookupEventPreset(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := s.CheckPreset(p); (err != nil) != refused {
+			t.Errorf("Yellow: CheckPreset(%s) = %v, want refused %v", name, err, refused)
+		}
+		if err := CreateTestSaveFor(profile.ProfileRedBlueNA).CheckPreset(p); err != nil {
+			t.Errorf("Red/Blue: CheckPreset(%s) = %v", name, err)
+		}
+	}
+}
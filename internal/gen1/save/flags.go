@@ -0,0 +1,89 @@
+package save
+
+import "fmt"
+
+// FlagArray is a bit array in the save: flag n is bit n%8 of byte n/8.
+// Event flags, hide/show flags, obtained hidden items and hidden coins are
+// stored this way.
+type FlagArray struct {
+	Name   string
+	Offset int
+	Count  int
+}
+
+const (
+	// MaxMissableObjects is the number of hide/show flags
+	MaxMissableObjects = 256
+	// MaxHiddenItems is the number of obtained hidden item flags
+	MaxHiddenItems = 112
+	// MaxHiddenCoins is the number of obtained hidden coin flags (the
+	// coins found on the Game Corner floor)
+	MaxHiddenCoins = 16
+)
+
+// EventFlags returns the event flag array
+func (s *Save) EventFlags() FlagArray {
+	return FlagArray{Name: "event", Offset: s.GetProfile().OffsetEventFlags, Count: MaxEventFlag}
+}
+
+// MissableObjectFlags returns the hide/show flags of the overworld sprites
+// the game can remove, such as item balls and legendary Pokémon. A set flag
+// hides the sprite.
+func (s *Save) MissableObjectFlags() FlagArray {
+	return FlagArray{Name: "hide/show", Offset: s.GetProfile().OffsetMissableObjects, Count: MaxMissableObjects}
+}
+
+// HiddenItemFlags returns the obtained hidden item flags, one per hidden
+// item in the game's hidden item table
+func (s *Save) HiddenItemFlags() FlagArray {
+	return FlagArray{Name: "hidden item", Offset: s.GetProfile().OffsetHiddenItems, Count: MaxHiddenItems}
+}
+
+// HiddenCoinFlags returns the obtained hidden coin flags
+func (s *Save) HiddenCoinFlags() FlagArray {
+	return FlagArray{Name: "hidden coin", Offset: s.GetProfile().OffsetHiddenCoins, Count: MaxHiddenCoins}
+}
+
+// Flag reports whether flag n of the array is set (false when out of range)
+func (s *Save) Flag(a FlagArray, n int) bool {
+	if n < 0 || n >= a.Count {
+		return false
+	}
+	return s.GetByte(a.Offset+n/8)&(1<<(n%8)) != 0
+}
+
+// SetFlag sets or clears flag n of the array
+func (s *Save) SetFlag(a FlagArray, n int, on bool) error {
+	if n < 0 || n >= a.Count {
+		return fmt.Errorf("%s flag %d out of range (0-%d)", a.Name, n, a.Count-1)
+	}
+	offset := a.Offset + n/8
+	b := s.GetByte(offset)
+	if on {
+		b |= 1 << (n % 8)
+	} else {
+		b &^= 1 << (n % 8)
+	}
+	return s.SetByte(offset, b)
+}
+
+// FlagsSet returns the number of every set flag of the array
+func (s *Save) FlagsSet(a FlagArray) []int {
+	flags := make([]int, 0)
+	for n := 0; n < a.Count; n++ {
+		if s.Flag(a, n) {
+			flags = append(flags, n)
+		}
+	}
+	return flags
+}
+
+// ClearFlags clears every flag of the array
+func (s *Save) ClearFlags(a FlagArray) error {
+	for n := 0; n < a.Count; n++ {
+		if err := s.SetFlag(a, n, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
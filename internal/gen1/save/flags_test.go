@@ -0,0 +1,37 @@
+package save
+
+import (
+	"testing"
+
+	"github.com/abravonunez/raracandy/internal/gen1/profile"
+)
+
+func TestFlagArray(t *testing.T) {
+	for _, prof := range []*profile.GameProfile{profile.ProfileYellowNA, profile.ProfileYellowJP} {
+		s := CreateTestSaveFor(prof)
+		items := s.HiddenItemFlags()
+
+		if err := s.SetFlag(items, 9, true); err != nil {
+			t.Fatal(err)
+		}
+		if got := s.GetByte(prof.OffsetHiddenItems + 1); got != 0x02 {
+			t.Errorf("%s: flag byte = 0x%02X, want 0x02", prof.Name, got)
+		}
+		s.SetFlag(items, 111, true)
+		if got := s.FlagsSet(items); len(got) != 2 || got[0] != 9 || got[1] != 111 {
+			t.Errorf("%s: FlagsSet() = %v, want [9 111]", prof.Name, got)
+		}
+		if err := s.SetFlag(items, MaxHiddenItems, true); err == nil {
+			t.Errorf("%s: SetFlag() should reject out-of-range flags", prof.Name)
+		}
+
+		// Clearing the hidden items must not touch the coin flags after them
+		s.SetFlag(s.HiddenCoinFlags(), 0, true)
+		if err := s.ClearFlags(items); err != nil {
+			t.Fatal(err)
+		}
+		if len(s.FlagsSet(items)) != 0 || !s.Flag(s.HiddenCoinFlags(), 0) {
+			t.Errorf("%s: ClearFlags() cleared the wrong flags", prof.Name)
+		}
+	}
+}
@@ -3,8 +3,11 @@ package save
 import (
 	"crypto/sha256"
 	"fmt"
+	"strings"
 
+	"github.com/abravonunez/raracandy/internal/gen1/bcd"
 	"github.com/abravonunez/raracandy/internal/gen1/profile"
+	"github.com/abravonunez/raracandy/internal/gen1/text"
 )
 
 // IntegrityReport contains the results of integrity checks
@@ -13,9 +16,27 @@ type IntegrityReport struct {
 	Errors        []string
 	Warnings      []string
 	GameVersion   profile.GameVersion
+	Region        profile.Region
+	Confidence    float64  // confidence in the Yellow vs Red/Blue decision
+	Evidence      []string // data the game decision was based on
 	ChecksumValid bool
 	BagValid      bool
 	MoneyValid    bool
+	CoinsValid    bool
+	OptionsValid  bool
+	Checksums     []ChecksumStatus
+}
+
+// ChecksumStatus describes one stored checksum and the value calculated for it
+type ChecksumStatus struct {
+	Name       string
+	Stored     byte
+	Calculated byte
+}
+
+// Valid reports whether the stored checksum matches the calculated one
+func (c ChecksumStatus) Valid() bool {
+	return c.Stored == c.Calculated
 }
 
 // CheckIntegrity performs comprehensive integrity checks on the save file
@@ -26,6 +47,19 @@ func (s *Save) CheckIntegrity() IntegrityReport {
 		Warnings:    make([]string, 0),
 		GameVersion: s.DetectGameVersion(),
 	}
+	if s.region != profile.RegionAuto || s.game != profile.GameAuto {
+		report.GameVersion = s.GetProfile().Version
+	}
+	report.Region = s.GetProfile().Region
+
+	if s.game != profile.GameAuto {
+		report.Confidence = 1
+		report.Evidence = []string{fmt.Sprintf("game set explicitly to %s", s.game)}
+	} else {
+		detection := s.DetectGame()
+		report.Confidence = detection.Confidence
+		report.Evidence = detection.Evidence
+	}
 
 	// 1. Checksum validation
 	report.ChecksumValid = s.ValidateChecksum()
@@ -33,6 +67,13 @@ func (s *Save) CheckIntegrity() IntegrityReport {
 		report.Errors = append(report.Errors, "Invalid checksum - save may be corrupted")
 		report.IsValid = false
 	}
+	report.Checksums = s.checksumStatuses()
+	for _, c := range report.Checksums[1:] {
+		if !c.Valid() {
+			report.Errors = append(report.Errors, fmt.Sprintf("Invalid checksum for %s: stored 0x%02X, calculated 0x%02X", c.Name, c.Stored, c.Calculated))
+			report.IsValid = false
+		}
+	}
 
 	// 2. Bag count validation
 	prof := s.GetProfile()
@@ -54,23 +95,29 @@ func (s *Save) CheckIntegrity() IntegrityReport {
 		}
 	}
 
-	// 4. Money validation (checked via money package)
-	// Money is stored in BCD, so we check if the bytes are valid BCD
-	report.MoneyValid = true // Assume valid until proven otherwise
-	moneyBytes := s.GetBytes(prof.OffsetMoney, 3)
-	if moneyBytes != nil {
-		for i, b := range moneyBytes {
-			high := (b >> 4) & 0x0F
-			low := b & 0x0F
-			if high > 9 || low > 9 {
-				report.Errors = append(report.Errors, fmt.Sprintf("Invalid BCD in money byte %d: 0x%02X", i, b))
-				report.IsValid = false
-				report.MoneyValid = false
-			}
-		}
+	// 3b. PC item storage count validation
+	pcCount := s.GetByte(prof.OffsetPCItemCount)
+	if pcCount > byte(prof.MaxPCItems) {
+		report.Errors = append(report.Errors, fmt.Sprintf("PC item count %d exceeds maximum %d", pcCount, prof.MaxPCItems))
+		report.IsValid = false
 	}
 
-	// 5. Version-specific checks
+	// 4. Money and coins are stored in BCD, so every nibble must be a digit
+	report.MoneyValid = s.checkBCD(&report, "money", prof.OffsetMoney, 3)
+	report.CoinsValid = s.checkBCD(&report, "coins", prof.OffsetCoins, 2)
+
+	// 5. Badges should match the gym leaders' defeat flags
+	report.Warnings = append(report.Warnings, s.checkBadgeFlags()...)
+
+	// 6. Story flags should be in a combination the game can reach
+	report.Warnings = append(report.Warnings, s.checkEventFlags()...)
+
+	// 7. The options byte should hold a combination the options menu can set
+	optionWarnings := s.checkOptions(report.GameVersion.Game())
+	report.OptionsValid = len(optionWarnings) == 0
+	report.Warnings = append(report.Warnings, optionWarnings...)
+
+	// 8. Version-specific checks
 	if report.GameVersion == profile.VersionUnknown {
 		report.Warnings = append(report.Warnings, "Could not detect game version - offsets may be incorrect")
 	}
@@ -78,60 +125,169 @@ func (s *Save) CheckIntegrity() IntegrityReport {
 	return report
 }
 
-// DetectGameVersion attempts to identify the game version
-// This uses hardcoded NA offsets for detection since all NA versions share the same offsets
+// checkBCD reports every byte of a BCD field holding a nibble above 9
+func (s *Save) checkBCD(report *IntegrityReport, name string, offset, size int) bool {
+	data := s.GetBytes(offset, size)
+	invalid := bcd.InvalidBytes(data)
+	for _, i := range invalid {
+		report.Errors = append(report.Errors, fmt.Sprintf("Invalid BCD in %s byte %d: 0x%02X", name, i, data[i]))
+		report.IsValid = false
+	}
+	return len(invalid) == 0
+}
+
+// checksumStatuses lists the main checksum followed, once the PC boxes have
+// been initialized, by the whole-bank and per-box checksums of banks 2 and 3
+func (s *Save) checksumStatuses() []ChecksumStatus {
+	prof := s.GetProfile()
+	statuses := []ChecksumStatus{{
+		Name:       "bank 1 (main data)",
+		Stored:     s.GetChecksum(),
+		Calculated: s.CalculateChecksum(),
+	}}
+
+	if !s.BoxesInitialized() {
+		return statuses
+	}
+
+	for bank := 0; bank < s.BoxBankCount(); bank++ {
+		first := bank * prof.BoxesPerBank
+		statuses = append(statuses, ChecksumStatus{
+			Name:       fmt.Sprintf("bank %d (boxes %d-%d)", bank+2, first+1, first+prof.BoxesPerBank),
+			Stored:     s.GetBankChecksum(bank),
+			Calculated: s.CalculateBankChecksum(bank),
+		})
+		for box := first; box < first+prof.BoxesPerBank; box++ {
+			statuses = append(statuses, ChecksumStatus{
+				Name:       fmt.Sprintf("box %d", box+1),
+				Stored:     s.GetBoxChecksum(box),
+				Calculated: s.CalculateBoxChecksum(box),
+			})
+		}
+	}
+
+	return statuses
+}
+
+// DetectGameVersion attempts to identify the game version from the region
+// (see DetectRegion) and the game (see DetectGame)
 func (s *Save) DetectGameVersion() profile.GameVersion {
-	// Constants for NA version detection (same for Red/Blue/Yellow NA)
-	const (
-		naOffsetChecksum = 0x3523
-		naChecksumStart  = 0x2598
-		naChecksumEnd    = 0x3522
-		naOffsetBagCount = 0x25C9
-		naOffsetMoney    = 0x25F3
-	)
+	var version profile.GameVersion
+	switch region := s.DetectRegion(); {
+	case region == profile.RegionJP:
+		version = profile.VersionYellowJP
+	case region.IsEuropean():
+		version = profile.VersionYellowEU
+	case region == profile.RegionNA:
+		version = profile.VersionYellowNA
+	default:
+		return profile.VersionUnknown
+	}
+	return version.WithGame(s.DetectGame().Game)
+}
+
+// DetectRegion attempts to identify the release a save comes from.
+// NA/EU and JP saves differ structurally (JP names are shorter, which shifts
+// the main data and moves the checksum), so each layout is tried in turn:
+// a valid main checksum is decisive, sane bag/party/money fields break ties.
+// NA and PAL saves share a layout and are told apart by the names only.
+func (s *Save) DetectRegion() profile.Region {
+	na := s.matchLayout(profile.ProfileYellowNA)
+	jp := s.matchLayout(profile.ProfileYellowJP)
+
+	switch {
+	case na.checksum && !jp.checksum:
+		return s.detectLanguage()
+	case jp.checksum && !na.checksum:
+		return profile.RegionJP
+	}
 
-	// 1. Validate checksum at NA offset
-	var sum byte = 0
-	for i := naChecksumStart; i <= naChecksumEnd; i++ {
-		sum += s.GetByte(i)
+	// Both or neither checksums match (e.g. a corrupted save): fall back to
+	// the structure, preferring NA when both layouts look reasonable
+	if na.structure {
+		return s.detectLanguage()
+	}
+	if jp.structure {
+		return profile.RegionJP
 	}
-	calculatedChecksum := ^sum
-	storedChecksum := s.GetByte(naOffsetChecksum)
 
-	checksumValid := (calculatedChecksum == storedChecksum)
+	return profile.RegionAuto
+}
 
-	// 2. Validate bag structure
-	bagCount := s.GetByte(naOffsetBagCount)
-	bagValid := bagCount <= 20
-
-	// 3. Validate money (BCD format)
-	moneyValid := true
-	moneyBytes := s.GetBytes(naOffsetMoney, 3)
-	if moneyBytes != nil {
-		for _, b := range moneyBytes {
-			high := (b >> 4) & 0x0F
-			low := b & 0x0F
-			if high > 9 || low > 9 {
-				moneyValid = false
-				break
-			}
+// languageHints lists glyphs that only one PAL language uses in names.
+// French/German and Italian/Spanish share a table, so a wrong guess within
+// a pair only affects the version label, never how text is encoded.
+var languageHints = []struct {
+	region profile.Region
+	glyphs string
+}{
+	{profile.RegionDE, "ÄÖÜäöüß"},
+	{profile.RegionFR, "àèéùçëïâôûêî"},
+	{profile.RegionES, "ÁÍÑÓÚáíñóú"},
+	{profile.RegionIT, "ÀÈÉÌÒÙìò"},
+}
+
+// detectLanguage looks at the player and rival names of an NA-layout save.
+// Names that could only have been typed with a PAL naming screen mark a
+// European save; otherwise the save is treated as NA (plain ASCII names
+// encode identically anyway).
+func (s *Save) detectLanguage() profile.Region {
+	base := profile.ProfileYellowNA
+	names := [][]byte{
+		s.GetBytes(base.OffsetPlayerName, base.NameLength),
+		s.GetBytes(base.OffsetRivalName, base.NameLength),
+	}
+
+	english := true
+	for _, name := range names {
+		if !text.IsValidName(name, text.English) {
+			english = false
 		}
 	}
+	if english {
+		return profile.RegionNA
+	}
 
-	// If checksum is valid and structure looks good, it's a NA version
-	if checksumValid && bagValid && moneyValid {
-		// Default to Yellow NA since offsets are identical for Red/Blue/Yellow NA
-		// Future: could differentiate by checking for Pikachu-specific data
-		return profile.VersionYellowNA
+	for _, hint := range languageHints {
+		cs := profile.ForRegion(profile.VersionYellowEU, hint.region).Charset
+		for _, name := range names {
+			if text.IsValidName(name, cs) && strings.ContainsAny(text.Decode(name, cs), hint.glyphs) {
+				return hint.region
+			}
+		}
 	}
+	return profile.RegionNA
+}
 
-	// If structure looks reasonable but checksum is invalid, still assume NA
-	// (checksum might be invalid for legitimate reasons, e.g., corrupted save)
-	if bagValid && moneyValid {
-		return profile.VersionYellowNA
+// layoutMatch records how well the save data fits a profile's layout
+type layoutMatch struct {
+	checksum  bool
+	structure bool
+}
+
+// matchLayout checks the main checksum and the bag, party and money fields
+// of the save against the offsets of a profile
+func (s *Save) matchLayout(p *profile.GameProfile) layoutMatch {
+	// 1. Validate checksum at the profile's offset
+	checksumValid := s.checksumRange(p.ChecksumStart, p.ChecksumEnd) == s.GetByte(p.OffsetChecksum)
+
+	// 2. Validate bag structure
+	bagValid := s.GetByte(p.OffsetBagCount) <= byte(p.MaxBagItems)
+
+	// 3. Validate party structure (species list ends with 0xFF)
+	partyCount := s.GetByte(p.OffsetParty)
+	partyValid := partyCount <= byte(p.MaxPartyMons)
+	if partyValid && partyCount > 0 {
+		partyValid = s.GetByte(p.OffsetParty+1+int(partyCount)) == 0xFF
 	}
 
-	return profile.VersionUnknown
+	// 4. Validate money (BCD format)
+	moneyValid := bcd.Valid(s.GetBytes(p.OffsetMoney, 3))
+
+	return layoutMatch{
+		checksum:  checksumValid,
+		structure: bagValid && partyValid && moneyValid,
+	}
 }
 
 // GetSHA256 returns the SHA256 hash of the save data
@@ -0,0 +1,151 @@
+package save
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/abravonunez/raracandy/internal/gen1/profile"
+)
+
+func TestDetectGameVersion(t *testing.T) {
+	tests := []struct {
+		name string
+		prof *profile.GameProfile
+		want profile.GameVersion
+	}{
+		{"NA", profile.ProfileYellowNA, profile.VersionYellowNA},
+		{"JP", profile.ProfileYellowJP, profile.VersionYellowJP},
+	}
+
+	for _, tt := range tests {
+		s := CreateTestSaveFor(tt.prof)
+		// A realistic party: two Pokémon followed by the list terminator
+		s.SetBytes(tt.prof.OffsetParty, []byte{2, 0x54, 0x99, 0xFF})
+		s.RecalculateChecksum()
+
+		if got := s.DetectGameVersion(); got != tt.want {
+			t.Errorf("%s: DetectGameVersion() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestLoadJapaneseSave(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "jp.sav")
+	s := CreateTestSaveFor(profile.ProfileYellowJP)
+	s.SetBytes(profile.ProfileYellowJP.OffsetParty, []byte{1, 0x54, 0xFF})
+	if err := s.Write(path); err != nil {
+		t.Fatal(err)
+	}
+
+	loaded, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if loaded.GetProfile() != profile.ProfileYellowJP {
+		t.Fatalf("Load() profile = %s, want %s", loaded.GetProfile().Name, profile.ProfileYellowJP.Name)
+	}
+	if !loaded.ValidateChecksum() {
+		t.Error("ValidateChecksum() should use the JP checksum offset")
+	}
+}
+
+func TestJapaneseBoxLayout(t *testing.T) {
+	prof := profile.ProfileYellowJP
+	if got := prof.BoxSize(); got != 0x566 {
+		t.Errorf("BoxSize() = 0x%X, want 0x566", got)
+	}
+	if got := prof.BankChecksumOffset(0); got != 0x5598 {
+		t.Errorf("BankChecksumOffset(0) = 0x%X, want 0x5598", got)
+	}
+	if got := prof.BoxOffset(7); got != 0x6000+3*0x566 {
+		t.Errorf("BoxOffset(7) = 0x%X", got)
+	}
+
+	s := CreateTestSaveFor(prof)
+	s.SetByte(prof.OffsetCurrentBoxNum, 0x80)
+	s.RecalculateChecksum()
+	report := s.CheckIntegrity()
+	// main + 2 banks + 8 boxes
+	if len(report.Checksums) != 11 {
+		t.Errorf("got %d checksum statuses, want 11", len(report.Checksums))
+	}
+	if !report.IsValid {
+		t.Errorf("CheckIntegrity() errors = %v", report.Errors)
+	}
+}
+
+func TestDetectEuropeanRegion(t *testing.T) {
+	prof := profile.ProfileYellowNA
+
+	tests := []struct {
+		name []byte
+		want profile.Region
+	}{
+		{[]byte{0x80, 0x92, 0x87, 0x50}, profile.RegionNA},       // ASH
+		{[]byte{0x89, 0xC5, 0xB1, 0xA6, 0x50}, profile.RegionDE}, // Jürg
+		{[]byte{0x87, 0xBC, 0xAB, 0x50}, profile.RegionFR},       // Hél
+		{[]byte{0x8D, 0xD3, 0x50}, profile.RegionIT},             // Nò
+		{[]byte{0x8D, 0xD2, 0x50}, profile.RegionES},             // Nñ
+	}
+
+	for _, tt := range tests {
+		s := CreateTestSave()
+		s.SetBytes(prof.OffsetPlayerName, tt.name)
+		s.SetBytes(prof.OffsetRivalName, []byte{0x81, 0x50})
+		s.RecalculateChecksum()
+
+		if got := s.DetectRegion(); got != tt.want {
+			t.Errorf("DetectRegion() with name % X = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestLoadWithRegion(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "eu.sav")
+	s := CreateTestSave()
+	s.SetBytes(profile.ProfileYellowNA.OffsetPlayerName, []byte{0x80, 0x50})
+	s.SetBytes(profile.ProfileYellowNA.OffsetRivalName, []byte{0x81, 0x50})
+	if err := s.Write(path); err != nil {
+		t.Fatal(err)
+	}
+
+	auto, err := Load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if auto.GetProfile() != profile.ProfileYellowNA {
+		t.Errorf("Load() profile = %s, want %s", auto.GetProfile().Name, profile.ProfileYellowNA.Name)
+	}
+
+	forced, err := LoadWith(path, LoadOptions{Region: profile.RegionDE})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if forced.GetProfile() != profile.ProfileYellowDE {
+		t.Errorf("LoadWith(de) profile = %s, want %s", forced.GetProfile().Name, profile.ProfileYellowDE.Name)
+	}
+	if report := forced.CheckIntegrity(); report.GameVersion != profile.VersionYellowEU || report.Region != profile.RegionDE {
+		t.Errorf("CheckIntegrity() version = %v, region = %v", report.GameVersion, report.Region)
+	}
+}
+
+func TestInvalidCoinBCD(t *testing.T) {
+	s := CreateTestSave()
+	s.SetBytes(s.GetProfile().OffsetCoins, []byte{0x12, 0x3F})
+	s.RecalculateChecksum()
+
+	report := s.CheckIntegrity()
+	if report.IsValid || report.CoinsValid {
+		t.Fatalf("invalid coin BCD should fail the integrity check: %+v", report)
+	}
+	if !report.MoneyValid {
+		t.Error("money should still be valid")
+	}
+	found := false
+	for _, e := range report.Errors {
+		found = found || e == "Invalid BCD in coins byte 1: 0x3F"
+	}
+	if !found {
+		t.Errorf("missing coins error in %v", report.Errors)
+	}
+}
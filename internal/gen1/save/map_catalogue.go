@@ -0,0 +1,132 @@
+package save
+
+import (
+	"fmt"
+	"strings"
+)
+
+// MapFlags lists the flags that belong to one map: the hidden items found
+// there (numbers in the hidden item flags), the Game Corner floor coins, and
+// for a gym the event flags of the gym trainers beaten there
+type MapFlags struct {
+	Name        string
+	HiddenItems []int
+	HiddenCoins []int
+	GymTrainers []int
+}
+
+// hiddenItemMaps is the map of every hidden item, in the order of the game's
+// hidden item table (HiddenItemCoords in the disassembly): hidden item n is
+// obtained flag n. The entry for an unused map is left empty.
+var hiddenItemMaps = []string{
+	"viridian-forest", "viridian-forest", "mt-moon-b2f", "route-25",
+	"route-9", "ss-anne-kitchen", "ss-anne-b1f-rooms", "route-10",
+	"route-10", "rocket-hideout-b1f", "rocket-hideout-b3f", "rocket-hideout-b4f",
+	"pokemon-tower-5f", "route-13", "route-13", "safari-zone-west",
+	"silph-co-5f", "silph-co-9f", "copycats-house-2f", "cerulean-cave-1f",
+	"cerulean-cave-b1f", "power-plant", "power-plant", "seafoam-islands-b2f",
+	"seafoam-islands-b4f", "pokemon-mansion-1f", "pokemon-mansion-3f", "pokemon-mansion-b1f",
+	"route-23", "route-23", "route-23", "victory-road-2f",
+	"victory-road-2f", "", "viridian-city", "route-11",
+	"route-12", "route-17", "route-17", "route-17",
+	"route-17", "route-17", "underground-path-north-south", "underground-path-north-south",
+	"underground-path-west-east", "underground-path-west-east", "celadon-city", "route-25",
+	"mt-moon-b2f", "seafoam-islands-b3f", "vermilion-city", "cerulean-city",
+	"route-4",
+}
+
+// gymTrainers lists the trainer event flags of each gym, in badge order. A
+// trainer's flag is the gym's first event flag plus its sprite number; the
+// leaders are story events (see EventCatalogue) and are not included. Only
+// the gyms are covered: trainers on other maps have no entry.
+var gymTrainers = []struct {
+	name  string
+	flags []int
+}{
+	{"pewter-gym", flagRange(0x072, 1)},
+	{"cerulean-gym", flagRange(0x0BA, 2)},
+	{"vermilion-gym", flagRange(0x162, 3)},
+	{"celadon-gym", flagRange(0x1AA, 7)},
+	{"fuchsia-gym", flagRange(0x25A, 6)},
+	{"cinnabar-gym", flagRange(0x29A, 7)},
+	{"saffron-gym", flagRange(0x352, 7)},
+	{"viridian-gym", flagRange(0x052, 8)},
+}
+
+// MapCatalogue lists the maps raracandy knows flags for: the maps of the
+// hidden item table in table order, the Game Corner, then the gyms
+var MapCatalogue = buildMapCatalogue()
+
+func buildMapCatalogue() []MapFlags {
+	var maps []MapFlags
+	index := make(map[string]int)
+	get := func(name string) *MapFlags {
+		i, ok := index[name]
+		if !ok {
+			i = len(maps)
+			index[name] = i
+			maps = append(maps, MapFlags{Name: name})
+		}
+		return &maps[i]
+	}
+
+	for n, name := range hiddenItemMaps {
+		if name != "" {
+			m := get(name)
+			m.HiddenItems = append(m.HiddenItems, n)
+		}
+	}
+	// Every hidden coin is on the Game Corner floor
+	get("game-corner").HiddenCoins = flagRange(0, MaxHiddenCoins)
+	for _, gym := range gymTrainers {
+		get(gym.name).GymTrainers = gym.flags
+	}
+	return maps
+}
+
+// flagRange returns count consecutive flag numbers starting at first
+func flagRange(first, count int) []int {
+	flags := make([]int, count)
+	for i := range flags {
+		flags[i] = first + i
+	}
+	return flags
+}
+
+// normalizeMapName lowercases a map name and strips separators so that
+// "Route 17", "route_17" and "route17" all match
+func normalizeMapName(name string) string {
+	replacer := strings.NewReplacer(" ", "", "_", "", "-", "", ".", "", "'", "")
+	return replacer.Replace(strings.ToLower(strings.TrimSpace(name)))
+}
+
+// LookupMap finds a map of the catalogue by name (case and separators are
+// ignored)
+func LookupMap(name string) (MapFlags, error) {
+	key := normalizeMapName(name)
+	for _, m := range MapCatalogue {
+		if normalizeMapName(m.Name) == key {
+			return m, nil
+		}
+	}
+	return MapFlags{}, fmt.Errorf("unknown map %q (run \"hidden-items list\" or \"gym-trainers list\" to see the maps)", name)
+}
+
+// HiddenItemMap returns the map of hidden item n, or "" when it is unknown
+func HiddenItemMap(n int) string {
+	if n < 0 || n >= len(hiddenItemMaps) {
+		return ""
+	}
+	return hiddenItemMaps[n]
+}
+
+// GymTrainerMaps returns the gyms of the catalogue, in badge order
+func GymTrainerMaps() []MapFlags {
+	maps := make([]MapFlags, 0, len(gymTrainers))
+	for _, m := range MapCatalogue {
+		if len(m.GymTrainers) > 0 {
+			maps = append(maps, m)
+		}
+	}
+	return maps
+}
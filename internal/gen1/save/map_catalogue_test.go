@@ -0,0 +1,58 @@
+package save
+
+import "testing"
+
+func TestLookupMap(t *testing.T) {
+	for _, name := range []string{"route-17", "Route 17", "ROUTE_17", "route17"} {
+		m, err := LookupMap(name)
+		if err != nil {
+			t.Fatalf("LookupMap(%q) failed: %v", name, err)
+		}
+		if m.Name != "route-17" || len(m.HiddenItems) != 5 {
+			t.Errorf("LookupMap(%q) = %+v", name, m)
+		}
+	}
+
+	if m, err := LookupMap("viridian-forest"); err != nil || len(m.HiddenItems) != 2 || m.HiddenItems[0] != 0 || m.HiddenItems[1] != 1 {
+		t.Errorf("LookupMap(viridian-forest) = %+v, %v", m, err)
+	}
+	if m, err := LookupMap("game-corner"); err != nil || len(m.HiddenCoins) != MaxHiddenCoins {
+		t.Errorf("LookupMap(game-corner) = %+v, %v", m, err)
+	}
+	if _, err := LookupMap("route-99"); err == nil {
+		t.Error("LookupMap(route-99) should fail")
+	}
+}
+
+func TestMapCatalogueFlags(t *testing.T) {
+	if len(hiddenItemMaps) > MaxHiddenItems {
+		t.Fatalf("hidden item table has %d entries, only %d flags", len(hiddenItemMaps), MaxHiddenItems)
+	}
+	if HiddenItemMap(0) != "viridian-forest" || HiddenItemMap(MaxHiddenItems-1) != "" {
+		t.Error("HiddenItemMap() returned wrong maps")
+	}
+
+	// Gym trainer flags must stay clear of the story events and of each other
+	story := make(map[int]string)
+	for _, e := range EventCatalogue {
+		story[e.Flag] = e.Name
+	}
+	owner := make(map[int]string)
+	for _, m := range GymTrainerMaps() {
+		for _, flag := range m.GymTrainers {
+			if flag < 0 || flag >= MaxEventFlag {
+				t.Errorf("%s: trainer flag 0x%03X out of range", m.Name, flag)
+			}
+			if name, ok := story[flag]; ok {
+				t.Errorf("%s: trainer flag 0x%03X is %s", m.Name, flag, name)
+			}
+			if other, ok := owner[flag]; ok {
+				t.Errorf("trainer flag 0x%03X is in both %s and %s", flag, other, m.Name)
+			}
+			owner[flag] = m.Name
+		}
+	}
+	if len(GymTrainerMaps()) != 8 {
+		t.Errorf("GymTrainerMaps() has %d maps, want the 8 gyms", len(GymTrainerMaps()))
+	}
+}
@@ -0,0 +1,23 @@
+package save
+
+import (
+	"fmt"
+
+	"github.com/abravonunez/raracandy/internal/gen1/profile"
+)
+
+// checkOptions warns about options bits the options menu never writes: a
+// text delay other than fast (1), medium (3) or slow (5), and sound bits
+// on Red/Blue, which have no sound setting
+func (s *Save) checkOptions(game profile.Game) []string {
+	options := s.GetByte(s.GetProfile().OffsetOptions)
+	warnings := make([]string, 0)
+
+	if delay := options & profile.OptionsTextDelayMask; !profile.ValidTextDelay(delay) {
+		warnings = append(warnings, fmt.Sprintf("Options byte 0x%02X has text speed %d, which is not fast (1), medium (3) or slow (5)", options, delay))
+	}
+	if sound := options & profile.OptionsSoundMask; sound != 0 && game == profile.GameRedBlue {
+		warnings = append(warnings, fmt.Sprintf("Options byte 0x%02X sets Yellow's sound bits on a Red/Blue save", options))
+	}
+	return warnings
+}
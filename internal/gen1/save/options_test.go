@@ -0,0 +1,27 @@
+package save
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestOptionsWarnings(t *testing.T) {
+	s := CreateTestSave()
+	if report := s.CheckIntegrity(); !report.OptionsValid {
+		t.Fatalf("medium text speed should be valid: %v", report.Warnings)
+	}
+
+	s.SetByte(s.GetProfile().OffsetOptions, 0x00)
+	s.RecalculateChecksum()
+
+	report := s.CheckIntegrity()
+	if report.OptionsValid {
+		t.Error("text speed 0 should be reported")
+	}
+	if !report.IsValid {
+		t.Errorf("options warnings should not invalidate the save: %v", report.Errors)
+	}
+	if warnings := strings.Join(report.Warnings, "\n"); !strings.Contains(warnings, "text speed 0") {
+		t.Errorf("missing text speed warning in %q", warnings)
+	}
+}
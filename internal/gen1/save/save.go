@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 
+	"github.com/abravonunez/raracandy/internal/atomicfile"
 	"github.com/abravonunez/raracandy/internal/gen1/profile"
 )
 
@@ -35,10 +36,25 @@ type Save struct {
 	data     []byte
 	filePath string
 	profile  *profile.GameProfile
+	region   profile.Region // forced region, RegionAuto when detected
+	game     profile.Game   // forced game, GameAuto when detected
+}
+
+// LoadOptions overrides parts of the automatic detection done by Load
+type LoadOptions struct {
+	// Region forces a release (and therefore a layout and character table)
+	Region profile.Region
+	// Game forces Yellow or Red/Blue
+	Game profile.Game
 }
 
 // Load reads a save file from disk
 func Load(path string) (*Save, error) {
+	return LoadWith(path, LoadOptions{})
+}
+
+// LoadWith reads a save file from disk, applying the given overrides
+func LoadWith(path string, opts LoadOptions) (*Save, error) {
 	data, err := os.ReadFile(path)
 	if err != nil {
 		return nil, fmt.Errorf("failed to read save file: %w", err)
@@ -47,11 +63,23 @@ func Load(path string) (*Save, error) {
 	s := &Save{
 		data:     data,
 		filePath: path,
+		region:   opts.Region,
+		game:     opts.Game,
 	}
 
-	// Detect game version and assign appropriate profile
+	// Detect game version and region and assign the appropriate profile
 	version := s.DetectGameVersion()
-	s.profile = profile.GetProfile(version)
+	if opts.Game != profile.GameAuto {
+		if version == profile.VersionUnknown {
+			version = profile.VersionYellowNA
+		}
+		version = version.WithGame(opts.Game)
+	}
+	region := opts.Region
+	if region == profile.RegionAuto {
+		region = s.DetectRegion()
+	}
+	s.profile = profile.ForRegion(version, region)
 
 	// Validate the save file
 	if err := s.Validate(); err != nil {
@@ -66,8 +94,8 @@ func (s *Save) Write(path string) error {
 	// Recalculate checksum before writing
 	s.RecalculateChecksum()
 
-	// Write to file with proper permissions
-	if err := os.WriteFile(path, s.data, 0644); err != nil {
+	// Write atomically, so a crash never leaves a truncated save behind
+	if err := atomicfile.WriteFile(path, s.data, 0644); err != nil {
 		return fmt.Errorf("failed to write save file: %w", err)
 	}
 
@@ -81,6 +109,14 @@ func (s *Save) Data() []byte {
 	return cpy
 }
 
+// Clone returns an independent copy of the save, e.g. to try edits before
+// applying them for real
+func (s *Save) Clone() *Save {
+	c := *s
+	c.data = s.Data()
+	return &c
+}
+
 // GetByte returns the byte at the given offset
 func (s *Save) GetByte(offset int) byte {
 	if offset < 0 || offset >= len(s.data) {
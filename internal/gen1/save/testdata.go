@@ -1,7 +1,14 @@
 package save
 
+import "github.com/abravonunez/raracandy/internal/gen1/profile"
+
 // CreateTestSave creates a minimal valid Pokemon Yellow save file for testing
 func CreateTestSave() *Save {
+	return CreateTestSaveFor(profile.ProfileYellowNA)
+}
+
+// CreateTestSaveFor creates a minimal valid save file laid out for the given profile
+func CreateTestSaveFor(prof *profile.GameProfile) *Save {
 	data := make([]byte, SaveSize)
 
 	// Initialize with zeros
@@ -12,14 +19,18 @@ func CreateTestSave() *Save {
 	s := &Save{
 		data:     data,
 		filePath: "test.sav",
+		profile:  prof,
 	}
 
 	// Set up minimal bag (empty for now)
-	s.SetByte(OffsetBagCount, 0)
-	s.SetByte(OffsetBagItems, 0xFF) // Terminator
+	s.SetByte(prof.OffsetBagCount, 0)
+	s.SetByte(prof.OffsetBagItems, 0xFF) // Terminator
 
 	// Set money to 0
-	s.SetBytes(OffsetMoney, []byte{0x00, 0x00, 0x00})
+	s.SetBytes(prof.OffsetMoney, []byte{0x00, 0x00, 0x00})
+
+	// New games start with medium text speed
+	s.SetByte(prof.OffsetOptions, 0x03)
 
 	// Calculate and set checksum
 	s.RecalculateChecksum()
@@ -4,16 +4,26 @@ import (
 	"fmt"
 )
 
+// ValidationError reports a save file that is structurally unusable
+// (wrong size or corrupted main checksum), as opposed to an I/O failure
+type ValidationError struct {
+	Reason string
+}
+
+func (e *ValidationError) Error() string {
+	return e.Reason
+}
+
 // Validate performs validation checks on the save file
 func (s *Save) Validate() error {
 	// Check file size
 	if len(s.data) != SaveSize {
-		return fmt.Errorf("invalid save file size: expected %d bytes, got %d bytes", SaveSize, len(s.data))
+		return &ValidationError{fmt.Sprintf("invalid save file size: expected %d bytes, got %d bytes", SaveSize, len(s.data))}
 	}
 
 	// Validate checksum
 	if !s.ValidateChecksum() {
-		return fmt.Errorf("checksum validation failed: save file may be corrupted")
+		return &ValidationError{"checksum validation failed: save file may be corrupted"}
 	}
 
 	return nil
@@ -22,6 +32,6 @@ func (s *Save) Validate() error {
 // ValidateChecksum checks if the current checksum is correct
 func (s *Save) ValidateChecksum() bool {
 	calculated := s.CalculateChecksum()
-	stored := s.GetByte(OffsetChecksum)
+	stored := s.GetChecksum()
 	return calculated == stored
 }
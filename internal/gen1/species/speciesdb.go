@@ -0,0 +1,310 @@
+package species
+
+import (
+	"fmt"
+	"strings"
+)
+
+// GrowthRate determines how much experience a species needs per level
+type GrowthRate int
+
+const (
+	GrowthMediumFast GrowthRate = iota
+	GrowthMediumSlow
+	GrowthFast
+	GrowthSlow
+)
+
+// BaseStats holds the species base stats used by the Gen 1 stat formula
+type BaseStats struct {
+	HP      int
+	Attack  int
+	Defense int
+	Speed   int
+	Special int
+}
+
+// Species describes a Pokémon species
+type Species struct {
+	Dex        int // National Pokédex number (1-151)
+	Name       string
+	GrowthRate GrowthRate
+	Base       BaseStats
+}
+
+const MaxDex = 151
+
+// speciesByDex lists every species in National Pokédex order (index 0 = #001)
+var speciesByDex = [MaxDex]Species{
+	{1, "Bulbasaur", GrowthMediumSlow, BaseStats{45, 49, 49, 45, 65}},
+	{2, "Ivysaur", GrowthMediumSlow, BaseStats{60, 62, 63, 60, 80}},
+	{3, "Venusaur", GrowthMediumSlow, BaseStats{80, 82, 83, 80, 100}},
+	{4, "Charmander", GrowthMediumSlow, BaseStats{39, 52, 43, 65, 50}},
+	{5, "Charmeleon", GrowthMediumSlow, BaseStats{58, 64, 58, 80, 65}},
+	{6, "Charizard", GrowthMediumSlow, BaseStats{78, 84, 78, 100, 85}},
+	{7, "Squirtle", GrowthMediumSlow, BaseStats{44, 48, 65, 43, 50}},
+	{8, "Wartortle", GrowthMediumSlow, BaseStats{59, 63, 80, 58, 65}},
+	{9, "Blastoise", GrowthMediumSlow, BaseStats{79, 83, 100, 78, 85}},
+	{10, "Caterpie", GrowthMediumFast, BaseStats{45, 30, 35, 45, 20}},
+	{11, "Metapod", GrowthMediumFast, BaseStats{50, 20, 55, 30, 25}},
+	{12, "Butterfree", GrowthMediumFast, BaseStats{60, 45, 50, 70, 80}},
+	{13, "Weedle", GrowthMediumFast, BaseStats{40, 35, 30, 50, 20}},
+	{14, "Kakuna", GrowthMediumFast, BaseStats{45, 25, 50, 35, 25}},
+	{15, "Beedrill", GrowthMediumFast, BaseStats{65, 80, 40, 75, 45}},
+	{16, "Pidgey", GrowthMediumSlow, BaseStats{40, 45, 40, 56, 35}},
+	{17, "Pidgeotto", GrowthMediumSlow, BaseStats{63, 60, 55, 71, 50}},
+	{18, "Pidgeot", GrowthMediumSlow, BaseStats{83, 80, 75, 91, 70}},
+	{19, "Rattata", GrowthMediumFast, BaseStats{30, 56, 35, 72, 25}},
+	{20, "Raticate", GrowthMediumFast, BaseStats{55, 81, 60, 97, 50}},
+	{21, "Spearow", GrowthMediumFast, BaseStats{40, 60, 30, 70, 31}},
+	{22, "Fearow", GrowthMediumFast, BaseStats{65, 90, 65, 100, 61}},
+	{23, "Ekans", GrowthMediumFast, BaseStats{35, 60, 44, 55, 40}},
+	{24, "Arbok", GrowthMediumFast, BaseStats{60, 85, 69, 80, 65}},
+	{25, "Pikachu", GrowthMediumFast, BaseStats{35, 55, 30, 90, 50}},
+	{26, "Raichu", GrowthMediumFast, BaseStats{60, 90, 55, 100, 90}},
+	{27, "Sandshrew", GrowthMediumFast, BaseStats{50, 75, 85, 40, 30}},
+	{28, "Sandslash", GrowthMediumFast, BaseStats{75, 100, 110, 65, 55}},
+	{29, "Nidoran♀", GrowthMediumSlow, BaseStats{55, 47, 52, 41, 40}},
+	{30, "Nidorina", GrowthMediumSlow, BaseStats{70, 62, 67, 56, 55}},
+	{31, "Nidoqueen", GrowthMediumSlow, BaseStats{90, 82, 87, 76, 75}},
+	{32, "Nidoran♂", GrowthMediumSlow, BaseStats{46, 57, 40, 50, 40}},
+	{33, "Nidorino", GrowthMediumSlow, BaseStats{61, 72, 57, 65, 55}},
+	{34, "Nidoking", GrowthMediumSlow, BaseStats{81, 92, 77, 85, 75}},
+	{35, "Clefairy", GrowthFast, BaseStats{70, 45, 48, 35, 60}},
+	{36, "Clefable", GrowthFast, BaseStats{95, 70, 73, 60, 85}},
+	{37, "Vulpix", GrowthMediumFast, BaseStats{38, 41, 40, 65, 65}},
+	{38, "Ninetales", GrowthMediumFast, BaseStats{73, 76, 75, 100, 100}},
+	{39, "Jigglypuff", GrowthFast, BaseStats{115, 45, 20, 20, 25}},
+	{40, "Wigglytuff", GrowthFast, BaseStats{140, 70, 45, 45, 50}},
+	{41, "Zubat", GrowthMediumFast, BaseStats{40, 45, 35, 55, 40}},
+	{42, "Golbat", GrowthMediumFast, BaseStats{75, 80, 70, 90, 75}},
+	{43, "Oddish", GrowthMediumSlow, BaseStats{45, 50, 55, 30, 75}},
+	{44, "Gloom", GrowthMediumSlow, BaseStats{60, 65, 70, 40, 85}},
+	{45, "Vileplume", GrowthMediumSlow, BaseStats{75, 80, 85, 50, 100}},
+	{46, "Paras", GrowthMediumFast, BaseStats{35, 70, 55, 25, 55}},
+	{47, "Parasect", GrowthMediumFast, BaseStats{60, 95, 80, 30, 80}},
+	{48, "Venonat", GrowthMediumFast, BaseStats{60, 55, 50, 45, 40}},
+	{49, "Venomoth", GrowthMediumFast, BaseStats{70, 65, 60, 90, 90}},
+	{50, "Diglett", GrowthMediumFast, BaseStats{10, 55, 25, 95, 45}},
+	{51, "Dugtrio", GrowthMediumFast, BaseStats{35, 80, 50, 120, 70}},
+	{52, "Meowth", GrowthMediumFast, BaseStats{40, 45, 35, 90, 40}},
+	{53, "Persian", GrowthMediumFast, BaseStats{65, 70, 60, 115, 65}},
+	{54, "Psyduck", GrowthMediumFast, BaseStats{50, 52, 48, 55, 50}},
+	{55, "Golduck", GrowthMediumFast, BaseStats{80, 82, 78, 85, 80}},
+	{56, "Mankey", GrowthMediumFast, BaseStats{40, 80, 35, 70, 35}},
+	{57, "Primeape", GrowthMediumFast, BaseStats{65, 105, 60, 95, 60}},
+	{58, "Growlithe", GrowthSlow, BaseStats{55, 70, 45, 60, 50}},
+	{59, "Arcanine", GrowthSlow, BaseStats{90, 110, 80, 95, 80}},
+	{60, "Poliwag", GrowthMediumSlow, BaseStats{40, 50, 40, 90, 40}},
+	{61, "Poliwhirl", GrowthMediumSlow, BaseStats{65, 65, 65, 90, 50}},
+	{62, "Poliwrath", GrowthMediumSlow, BaseStats{90, 85, 95, 70, 70}},
+	{63, "Abra", GrowthMediumSlow, BaseStats{25, 20, 15, 90, 105}},
+	{64, "Kadabra", GrowthMediumSlow, BaseStats{40, 35, 30, 105, 120}},
+	{65, "Alakazam", GrowthMediumSlow, BaseStats{55, 50, 45, 120, 135}},
+	{66, "Machop", GrowthMediumSlow, BaseStats{70, 80, 50, 35, 35}},
+	{67, "Machoke", GrowthMediumSlow, BaseStats{80, 100, 70, 45, 50}},
+	{68, "Machamp", GrowthMediumSlow, BaseStats{90, 130, 80, 55, 65}},
+	{69, "Bellsprout", GrowthMediumSlow, BaseStats{50, 75, 35, 40, 70}},
+	{70, "Weepinbell", GrowthMediumSlow, BaseStats{65, 90, 50, 55, 85}},
+	{71, "Victreebel", GrowthMediumSlow, BaseStats{80, 105, 65, 70, 100}},
+	{72, "Tentacool", GrowthSlow, BaseStats{40, 40, 35, 70, 100}},
+	{73, "Tentacruel", GrowthSlow, BaseStats{80, 70, 65, 100, 120}},
+	{74, "Geodude", GrowthMediumSlow, BaseStats{40, 80, 100, 20, 30}},
+	{75, "Graveler", GrowthMediumSlow, BaseStats{55, 95, 115, 35, 45}},
+	{76, "Golem", GrowthMediumSlow, BaseStats{80, 110, 130, 45, 55}},
+	{77, "Ponyta", GrowthMediumFast, BaseStats{50, 85, 55, 90, 65}},
+	{78, "Rapidash", GrowthMediumFast, BaseStats{65, 100, 70, 105, 80}},
+	{79, "Slowpoke", GrowthMediumFast, BaseStats{90, 65, 65, 15, 40}},
+	{80, "Slowbro", GrowthMediumFast, BaseStats{95, 75, 110, 30, 80}},
+	{81, "Magnemite", GrowthMediumFast, BaseStats{25, 35, 70, 45, 95}},
+	{82, "Magneton", GrowthMediumFast, BaseStats{50, 60, 95, 70, 120}},
+	{83, "Farfetch'd", GrowthMediumFast, BaseStats{52, 65, 55, 60, 58}},
+	{84, "Doduo", GrowthMediumFast, BaseStats{35, 85, 45, 75, 35}},
+	{85, "Dodrio", GrowthMediumFast, BaseStats{60, 110, 70, 100, 60}},
+	{86, "Seel", GrowthMediumFast, BaseStats{65, 45, 55, 45, 70}},
+	{87, "Dewgong", GrowthMediumFast, BaseStats{90, 70, 80, 70, 95}},
+	{88, "Grimer", GrowthMediumFast, BaseStats{80, 80, 50, 25, 40}},
+	{89, "Muk", GrowthMediumFast, BaseStats{105, 105, 75, 50, 65}},
+	{90, "Shellder", GrowthSlow, BaseStats{30, 65, 100, 40, 45}},
+	{91, "Cloyster", GrowthSlow, BaseStats{50, 95, 180, 70, 85}},
+	{92, "Gastly", GrowthMediumSlow, BaseStats{30, 35, 30, 80, 100}},
+	{93, "Haunter", GrowthMediumSlow, BaseStats{45, 50, 45, 95, 115}},
+	{94, "Gengar", GrowthMediumSlow, BaseStats{60, 65, 60, 110, 130}},
+	{95, "Onix", GrowthMediumFast, BaseStats{35, 45, 160, 70, 30}},
+	{96, "Drowzee", GrowthMediumFast, BaseStats{60, 48, 45, 42, 90}},
+	{97, "Hypno", GrowthMediumFast, BaseStats{85, 73, 70, 67, 115}},
+	{98, "Krabby", GrowthMediumFast, BaseStats{30, 105, 90, 50, 25}},
+	{99, "Kingler", GrowthMediumFast, BaseStats{55, 130, 115, 75, 50}},
+	{100, "Voltorb", GrowthMediumFast, BaseStats{40, 30, 50, 100, 55}},
+	{101, "Electrode", GrowthMediumFast, BaseStats{60, 50, 70, 140, 80}},
+	{102, "Exeggcute", GrowthSlow, BaseStats{60, 40, 80, 40, 60}},
+	{103, "Exeggutor", GrowthSlow, BaseStats{95, 95, 85, 55, 125}},
+	{104, "Cubone", GrowthMediumFast, BaseStats{50, 50, 95, 35, 40}},
+	{105, "Marowak", GrowthMediumFast, BaseStats{60, 80, 110, 45, 50}},
+	{106, "Hitmonlee", GrowthMediumFast, BaseStats{50, 120, 53, 87, 35}},
+	{107, "Hitmonchan", GrowthMediumFast, BaseStats{50, 105, 79, 76, 35}},
+	{108, "Lickitung", GrowthMediumFast, BaseStats{90, 55, 75, 30, 60}},
+	{109, "Koffing", GrowthMediumFast, BaseStats{40, 65, 95, 35, 60}},
+	{110, "Weezing", GrowthMediumFast, BaseStats{65, 90, 120, 60, 85}},
+	{111, "Rhyhorn", GrowthSlow, BaseStats{80, 85, 95, 25, 30}},
+	{112, "Rhydon", GrowthSlow, BaseStats{105, 130, 120, 40, 45}},
+	{113, "Chansey", GrowthFast, BaseStats{250, 5, 5, 50, 105}},
+	{114, "Tangela", GrowthMediumFast, BaseStats{65, 55, 115, 60, 100}},
+	{115, "Kangaskhan", GrowthMediumFast, BaseStats{105, 95, 80, 90, 40}},
+	{116, "Horsea", GrowthMediumFast, BaseStats{30, 40, 70, 60, 70}},
+	{117, "Seadra", GrowthMediumFast, BaseStats{55, 65, 95, 85, 95}},
+	{118, "Goldeen", GrowthMediumFast, BaseStats{45, 67, 60, 63, 50}},
+	{119, "Seaking", GrowthMediumFast, BaseStats{80, 92, 65, 68, 80}},
+	{120, "Staryu", GrowthSlow, BaseStats{30, 45, 55, 85, 70}},
+	{121, "Starmie", GrowthSlow, BaseStats{60, 75, 85, 115, 100}},
+	{122, "Mr. Mime", GrowthMediumFast, BaseStats{40, 45, 65, 90, 100}},
+	{123, "Scyther", GrowthMediumFast, BaseStats{70, 110, 80, 105, 55}},
+	{124, "Jynx", GrowthMediumFast, BaseStats{65, 50, 35, 95, 95}},
+	{125, "Electabuzz", GrowthMediumFast, BaseStats{65, 83, 57, 105, 85}},
+	{126, "Magmar", GrowthMediumFast, BaseStats{65, 95, 57, 93, 85}},
+	{127, "Pinsir", GrowthSlow, BaseStats{65, 125, 100, 85, 55}},
+	{128, "Tauros", GrowthSlow, BaseStats{75, 100, 95, 110, 70}},
+	{129, "Magikarp", GrowthSlow, BaseStats{20, 10, 55, 80, 20}},
+	{130, "Gyarados", GrowthSlow, BaseStats{95, 125, 79, 81, 100}},
+	{131, "Lapras", GrowthSlow, BaseStats{130, 85, 80, 60, 95}},
+	{132, "Ditto", GrowthMediumFast, BaseStats{48, 48, 48, 48, 48}},
+	{133, "Eevee", GrowthMediumFast, BaseStats{55, 55, 50, 55, 65}},
+	{134, "Vaporeon", GrowthMediumFast, BaseStats{130, 65, 60, 65, 110}},
+	{135, "Jolteon", GrowthMediumFast, BaseStats{65, 65, 60, 130, 110}},
+	{136, "Flareon", GrowthMediumFast, BaseStats{65, 130, 60, 65, 110}},
+	{137, "Porygon", GrowthMediumFast, BaseStats{65, 60, 70, 40, 75}},
+	{138, "Omanyte", GrowthMediumFast, BaseStats{35, 40, 100, 35, 90}},
+	{139, "Omastar", GrowthMediumFast, BaseStats{70, 60, 125, 55, 115}},
+	{140, "Kabuto", GrowthMediumFast, BaseStats{30, 80, 90, 55, 45}},
+	{141, "Kabutops", GrowthMediumFast, BaseStats{60, 115, 105, 80, 70}},
+	{142, "Aerodactyl", GrowthSlow, BaseStats{80, 105, 65, 130, 60}},
+	{143, "Snorlax", GrowthSlow, BaseStats{160, 110, 65, 30, 65}},
+	{144, "Articuno", GrowthSlow, BaseStats{90, 85, 100, 85, 125}},
+	{145, "Zapdos", GrowthSlow, BaseStats{90, 90, 85, 100, 125}},
+	{146, "Moltres", GrowthSlow, BaseStats{90, 100, 90, 90, 125}},
+	{147, "Dratini", GrowthSlow, BaseStats{41, 64, 45, 50, 50}},
+	{148, "Dragonair", GrowthSlow, BaseStats{61, 84, 65, 70, 70}},
+	{149, "Dragonite", GrowthSlow, BaseStats{91, 134, 95, 80, 100}},
+	{150, "Mewtwo", GrowthSlow, BaseStats{106, 110, 90, 130, 154}},
+	{151, "Mew", GrowthMediumSlow, BaseStats{100, 100, 100, 100, 100}},
+}
+
+// dexByIndex maps the internal species index stored in save data to its
+// National Pokédex number. Zero entries are MissingNo. slots.
+var dexByIndex = [256]int{
+	0x01: 112, 0x02: 115, 0x03: 32, 0x04: 35, 0x05: 21, 0x06: 100, 0x07: 34, 0x08: 80,
+	0x09: 2, 0x0A: 103, 0x0B: 108, 0x0C: 102, 0x0D: 88, 0x0E: 94, 0x0F: 29, 0x10: 31,
+	0x11: 104, 0x12: 111, 0x13: 131, 0x14: 59, 0x15: 151, 0x16: 130, 0x17: 90, 0x18: 72,
+	0x19: 92, 0x1A: 123, 0x1B: 120, 0x1C: 9, 0x1D: 127, 0x1E: 114,
+	0x21: 58, 0x22: 95, 0x23: 22, 0x24: 16, 0x25: 79, 0x26: 64, 0x27: 75, 0x28: 113,
+	0x29: 67, 0x2A: 122, 0x2B: 106, 0x2C: 107, 0x2D: 24, 0x2E: 47, 0x2F: 54, 0x30: 96,
+	0x31: 76, 0x33: 126, 0x35: 125, 0x36: 82, 0x37: 109, 0x39: 56, 0x3A: 86, 0x3B: 50,
+	0x3C: 128, 0x40: 83, 0x41: 48, 0x42: 149, 0x46: 84, 0x47: 60, 0x48: 124, 0x49: 146,
+	0x4A: 144, 0x4B: 145, 0x4C: 132, 0x4D: 52, 0x4E: 98, 0x52: 37, 0x53: 38, 0x54: 25,
+	0x55: 26, 0x58: 147, 0x59: 148, 0x5A: 140, 0x5B: 141, 0x5C: 116, 0x5D: 117, 0x60: 27,
+	0x61: 28, 0x62: 138, 0x63: 139, 0x64: 39, 0x65: 40, 0x66: 133, 0x67: 136, 0x68: 135,
+	0x69: 134, 0x6A: 66, 0x6B: 41, 0x6C: 23, 0x6D: 46, 0x6E: 61, 0x6F: 62, 0x70: 13,
+	0x71: 14, 0x72: 15, 0x74: 85, 0x75: 57, 0x76: 51, 0x77: 49, 0x78: 87, 0x7B: 10,
+	0x7C: 11, 0x7D: 12, 0x7E: 68, 0x80: 55, 0x81: 97, 0x82: 42, 0x83: 150, 0x84: 143,
+	0x85: 129, 0x88: 89, 0x8A: 99, 0x8B: 91, 0x8D: 101, 0x8E: 36, 0x8F: 110, 0x90: 53,
+	0x91: 105, 0x93: 93, 0x94: 63, 0x95: 65, 0x96: 17, 0x97: 18, 0x98: 121, 0x99: 1,
+	0x9A: 3, 0x9B: 73, 0x9D: 118, 0x9E: 119, 0xA3: 77, 0xA4: 78, 0xA5: 19, 0xA6: 20,
+	0xA7: 33, 0xA8: 30, 0xA9: 74, 0xAA: 137, 0xAB: 142, 0xAD: 81, 0xB0: 4, 0xB1: 7,
+	0xB2: 5, 0xB3: 8, 0xB4: 6, 0xB9: 43, 0xBA: 44, 0xBB: 45, 0xBC: 69, 0xBD: 70,
+	0xBE: 71,
+}
+
+// indexByDex is the reverse of dexByIndex, built at init
+var indexByDex [MaxDex + 1]byte
+
+// indexByName maps normalized species names to internal indexes
+var indexByName = make(map[string]byte, MaxDex)
+
+func init() {
+	for index, dex := range dexByIndex {
+		if dex == 0 {
+			continue
+		}
+		indexByDex[dex] = byte(index)
+		indexByName[normalizeName(speciesByDex[dex-1].Name)] = byte(index)
+	}
+}
+
+// normalizeName lowercases a name and strips punctuation so that
+// "Mr. Mime", "mr_mime" and "mrmime" all match
+func normalizeName(name string) string {
+	replacer := strings.NewReplacer(
+		"♀", "f", "♂", "m",
+		" ", "", "_", "", "-", "", ".", "", "'", "",
+	)
+	return replacer.Replace(strings.ToLower(strings.TrimSpace(name)))
+}
+
+// ByIndex returns the species for an internal index
+func ByIndex(index byte) (Species, bool) {
+	dex := dexByIndex[index]
+	if dex == 0 {
+		return Species{}, false
+	}
+	return speciesByDex[dex-1], true
+}
+
+// DexNumber returns the National Pokédex number for an internal index, or 0 for glitch indexes
+func DexNumber(index byte) int {
+	return dexByIndex[index]
+}
+
+// IndexFromDex returns the internal index for a National Pokédex number
+func IndexFromDex(dex int) (byte, error) {
+	if dex < 1 || dex > MaxDex {
+		return 0, fmt.Errorf("dex number %d out of range (1-%d)", dex, MaxDex)
+	}
+	return indexByDex[dex], nil
+}
+
+// GetIndex returns the internal index for a species name (case-insensitive)
+func GetIndex(name string) (byte, error) {
+	index, ok := indexByName[normalizeName(name)]
+	if !ok {
+		return 0, fmt.Errorf("unknown species: %s", name)
+	}
+	return index, nil
+}
+
+// GetName returns the species name for an internal index
+func GetName(index byte) string {
+	sp, ok := ByIndex(index)
+	if !ok {
+		return fmt.Sprintf("MissingNo. (0x%02X)", index)
+	}
+	return sp.Name
+}
+
+// ExpForLevel returns the minimum experience required to reach a level
+func (g GrowthRate) ExpForLevel(level int) uint32 {
+	n := level
+	var exp int
+	switch g {
+	case GrowthFast:
+		exp = 4 * n * n * n / 5
+	case GrowthMediumSlow:
+		exp = 6*n*n*n/5 - 15*n*n + 100*n - 140
+	case GrowthSlow:
+		exp = 5 * n * n * n / 4
+	default:
+		exp = n * n * n
+	}
+	if exp < 0 {
+		return 0
+	}
+	return uint32(exp)
+}
+
+// LevelForExp returns the highest level whose experience requirement is met
+func (g GrowthRate) LevelForExp(exp uint32) int {
+	level := 1
+	for level < 100 && g.ExpForLevel(level+1) <= exp {
+		level++
+	}
+	return level
+}
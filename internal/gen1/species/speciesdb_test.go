@@ -0,0 +1,71 @@
+package species
+
+import "testing"
+
+func TestIndexMappingCoversEveryDexNumber(t *testing.T) {
+	seen := make(map[int]bool, MaxDex)
+	for index := 0; index < 256; index++ {
+		dex := DexNumber(byte(index))
+		if dex == 0 {
+			continue
+		}
+		if seen[dex] {
+			t.Errorf("dex #%03d mapped from more than one index", dex)
+		}
+		seen[dex] = true
+
+		back, err := IndexFromDex(dex)
+		if err != nil || back != byte(index) {
+			t.Errorf("IndexFromDex(%d) = 0x%02X, %v; want 0x%02X", dex, back, err, index)
+		}
+	}
+	if len(seen) != MaxDex {
+		t.Errorf("mapping covers %d species, want %d", len(seen), MaxDex)
+	}
+}
+
+func TestGetIndex(t *testing.T) {
+	tests := []struct {
+		name  string
+		index byte
+	}{
+		{"Pikachu", 0x54},
+		{"mr_mime", 0x2A},
+		{"Mr. Mime", 0x2A},
+		{"nidoran_f", 0x0F},
+		{"Nidoran♂", 0x03},
+		{"farfetchd", 0x40},
+	}
+
+	for _, tt := range tests {
+		index, err := GetIndex(tt.name)
+		if err != nil || index != tt.index {
+			t.Errorf("GetIndex(%q) = 0x%02X, %v; want 0x%02X", tt.name, index, err, tt.index)
+		}
+	}
+}
+
+func TestExpForLevel(t *testing.T) {
+	tests := []struct {
+		rate  GrowthRate
+		level int
+		exp   uint32
+	}{
+		{GrowthMediumFast, 100, 1000000},
+		{GrowthMediumSlow, 100, 1059860},
+		{GrowthFast, 100, 800000},
+		{GrowthSlow, 100, 1250000},
+		{GrowthMediumSlow, 1, 0},
+	}
+
+	for _, tt := range tests {
+		if got := tt.rate.ExpForLevel(tt.level); got != tt.exp {
+			t.Errorf("ExpForLevel(%d) = %d, want %d", tt.level, got, tt.exp)
+		}
+		if tt.level > 1 {
+			if got := tt.rate.LevelForExp(tt.exp); got != tt.level {
+				t.Errorf("LevelForExp(%d) = %d, want %d", tt.exp, got, tt.level)
+			}
+		}
+	}
+}
@@ -0,0 +1,130 @@
+package text
+
+import "strings"
+
+func init() {
+	tables[English] = englishTable()
+	tables[Japanese] = japaneseTable()
+
+	// French and German share one table, Italian and Spanish another
+	frenchGerman := frenchGermanTable()
+	tables[French] = frenchGerman
+	tables[German] = frenchGerman
+	italianSpanish := italianSpanishTable()
+	tables[Italian] = italianSpanish
+	tables[Spanish] = italianSpanish
+}
+
+// run assigns consecutive bytes, starting at start, to each rune of glyphs
+func run(m map[byte]string, start byte, glyphs string) {
+	b := start
+	for _, r := range glyphs {
+		m[b] = string(r)
+		b++
+	}
+}
+
+// runes splits a string into one glyph per rune
+func runes(s string) []string {
+	return strings.Split(s, "")
+}
+
+func englishTable() *table {
+	m := map[byte]string{Space: " "}
+	run(m, 0x80, "ABCDEFGHIJKLMNOPQRSTUVWXYZ():;[]")
+	run(m, 0xA0, "abcdefghijklmnopqrstuvwxyzé")
+	m[0xBB] = "'d"
+	m[0xBC] = "'l"
+	m[0xBD] = "'s"
+	m[0xBE] = "'t"
+	m[0xBF] = "'v"
+	m[0xE0] = "'"
+	m[0xE1] = "<PK>"
+	m[0xE2] = "<MN>"
+	m[0xE3] = "-"
+	m[0xE4] = "'r"
+	m[0xE5] = "'m"
+	run(m, 0xE6, "?!.")
+	run(m, 0xEC, "▷▶▼♂¥×./,♀0123456789")
+
+	naming := runes("ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz×():;[]-?!♂♀/.,")
+	naming = append(naming, "<PK>", "<MN>")
+	return newTable(m, naming)
+}
+
+// europeanBase returns the glyphs common to every PAL table: the English
+// layout without its contractions, which the localized tables replace
+func europeanBase() map[byte]string {
+	m := map[byte]string{Space: " "}
+	run(m, 0x80, "ABCDEFGHIJKLMNOPQRSTUVWXYZ():;[]")
+	run(m, 0xA0, "abcdefghijklmnopqrstuvwxyz")
+	m[0xE0] = "'"
+	m[0xE1] = "<PK>"
+	m[0xE2] = "<MN>"
+	m[0xE3] = "-"
+	run(m, 0xE6, "?!.")
+	run(m, 0xEC, "▷▶▼♂¥×./,♀0123456789")
+	return m
+}
+
+// europeanNaming returns the naming-screen glyphs plus a table's accented letters
+func europeanNaming(accents string) []string {
+	naming := runes("ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz×():;[]-?!♂♀/.," + accents)
+	return append(naming, "<PK>", "<MN>")
+}
+
+func frenchGermanTable() *table {
+	m := europeanBase()
+	run(m, 0xBA, "àèéùßç")
+	run(m, 0xC0, "ÄÖÜäöüëïâôûêî")
+	m[0xD4] = "c'"
+	m[0xD5] = "d'"
+	m[0xD6] = "j'"
+	m[0xD7] = "l'"
+	m[0xD8] = "m'"
+	m[0xD9] = "n'"
+	m[0xDA] = "p'"
+	m[0xDB] = "s'"
+	m[0xDC] = "'s"
+	m[0xDD] = "t'"
+	m[0xDE] = "u'"
+	m[0xDF] = "y'"
+	return newTable(m, europeanNaming("àèéùßçÄÖÜäöüëïâôûêî"))
+}
+
+func italianSpanishTable() *table {
+	m := europeanBase()
+	run(m, 0xBA, "àèéùÀÁ")
+	run(m, 0xC0, "ÄÖÜäöüÈÉÌÍÑÒÓÙÚáìíñòóú")
+	return newTable(m, europeanNaming("àèéùÀÁÄÖÜäöüÈÉÌÍÑÒÓÙÚáìíñòóú"))
+}
+
+func japaneseTable() *table {
+	m := map[byte]string{Space: " "}
+	run(m, 0x05, "ガギグゲゴザジズゼゾダヂヅデド")
+	run(m, 0x19, "バビブボ")
+	run(m, 0x26, "がぎぐげござじずぜぞだぢづでど")
+	run(m, 0x3A, "ばびぶべぼ")
+	run(m, 0x40, "パピプポぱぴぷぺぽ")
+	run(m, 0x80, "アイウエオカキクケコサシスセソタチツテトナニヌネノハヒフホマミムメモヤユヨラルレロワヲンッャュョィ")
+	run(m, 0xB1, "あいうえおかきくけこさしすせそたちつてとなにぬねのはひふへほまみむめもやゆよらリるれろわをんっゃゅょー゜゛？！。ァゥェ▷▶▼♂円×./ォ♀0123456789")
+
+	t := newTable(m, nil)
+	// Katakana that the game draws with the hiragana glyph, and the reverse
+	t.alias("ヘ", 0xCD)
+	t.alias("ベ", 0x3D)
+	t.alias("ペ", 0x47)
+	t.alias("り", 0xD8)
+	t.alias("?", 0xE6)
+	t.alias("!", 0xE7)
+
+	for b := 0x05; b <= 0xE2; b++ {
+		if g, ok := m[byte(b)]; ok && b != Space {
+			t.naming[g] = true
+		}
+	}
+	for _, g := range []string{"ヘ", "ベ", "ペ", "り", "ー", "？", "！", "?", "!", "♂", "♀", "×", ".", "/"} {
+		t.naming[g] = true
+	}
+	return t
+}
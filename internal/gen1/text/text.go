@@ -0,0 +1,219 @@
+package text
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	// Terminator ends every Gen 1 string
+	Terminator = 0x50
+	// Space is the blank glyph in every table
+	Space = 0x7F
+)
+
+// Charset selects the character table used by a game's text
+type Charset int
+
+const (
+	English Charset = iota
+	Japanese
+	French
+	German
+	Italian
+	Spanish
+)
+
+func (c Charset) String() string {
+	switch c {
+	case Japanese:
+		return "Japanese"
+	case French:
+		return "French"
+	case German:
+		return "German"
+	case Italian:
+		return "Italian"
+	case Spanish:
+		return "Spanish"
+	default:
+		return "English"
+	}
+}
+
+// table holds the glyph mapping of one charset. decode maps bytes to the
+// string shown to the user; encode maps strings back to bytes, and may
+// contain aliases that are not produced by decode.
+type table struct {
+	decode map[byte]string
+	encode map[string]byte
+	// naming holds the glyphs offered by the in-game naming screen
+	naming map[string]bool
+	// maxToken is the longest encode key, in runes
+	maxToken int
+}
+
+var tables = map[Charset]*table{}
+
+func newTable(decode map[byte]string, naming []string) *table {
+	t := &table{decode: decode, encode: make(map[string]byte), naming: make(map[string]bool)}
+	for _, g := range naming {
+		t.naming[g] = true
+	}
+	// Walk in byte order so that glyphs drawn twice (e.g. the two periods)
+	// always encode to the lower byte
+	for b := 0; b < 256; b++ {
+		if s, ok := decode[byte(b)]; ok {
+			t.alias(s, byte(b))
+		}
+	}
+	return t
+}
+
+func (t *table) alias(s string, b byte) {
+	if _, ok := t.encode[s]; ok {
+		return
+	}
+	t.encode[s] = b
+	if n := utf8.RuneCountInString(s); n > t.maxToken {
+		t.maxToken = n
+	}
+}
+
+// Decode converts Gen 1 text up to the first terminator into a string.
+// Bytes without a glyph are shown as "?".
+func Decode(data []byte, cs Charset) string {
+	t := tables[cs]
+	var sb strings.Builder
+	for _, b := range data {
+		if b == Terminator {
+			break
+		}
+		if s, ok := t.decode[b]; ok {
+			sb.WriteString(s)
+		} else {
+			sb.WriteString("?")
+		}
+	}
+	return sb.String()
+}
+
+// Decodable reports whether every byte up to the first terminator has a
+// glyph in the charset. Unterminated data is not decodable.
+func Decodable(data []byte, cs Charset) bool {
+	t := tables[cs]
+	for _, b := range data {
+		if b == Terminator {
+			return true
+		}
+		if _, ok := t.decode[b]; !ok {
+			return false
+		}
+	}
+	return false
+}
+
+// IsValidName reports whether raw name data could have been entered on the
+// charset's naming screen: terminated, and made only of naming glyphs
+func IsValidName(data []byte, cs Charset) bool {
+	if !Decodable(data, cs) {
+		return false
+	}
+	t := tables[cs]
+	for _, b := range data {
+		if b == Terminator {
+			break
+		}
+		if b != Space && !t.naming[t.decode[b]] {
+			return false
+		}
+	}
+	return true
+}
+
+// Encode converts a string into Gen 1 text followed by a terminator.
+// Multi-character glyphs are written as tokens such as "<PK>".
+func Encode(s string, cs Charset) ([]byte, error) {
+	glyphs, err := split(s, cs)
+	if err != nil {
+		return nil, err
+	}
+	return append(glyphs, Terminator), nil
+}
+
+// Length returns the number of glyphs a string occupies once encoded
+func Length(s string, cs Charset) (int, error) {
+	glyphs, err := split(s, cs)
+	return len(glyphs), err
+}
+
+// EncodeName encodes a player, rival or Pokémon name. Only glyphs offered by
+// the in-game naming screen are accepted, and at most maxLen of them.
+func EncodeName(name string, cs Charset, maxLen int) ([]byte, error) {
+	t := tables[cs]
+	if name == "" {
+		return nil, fmt.Errorf("name cannot be empty")
+	}
+
+	glyphs, err := split(name, cs)
+	if err != nil {
+		return nil, err
+	}
+	if len(glyphs) > maxLen {
+		return nil, fmt.Errorf("name %q is %d characters long, maximum is %d", name, len(glyphs), maxLen)
+	}
+	for _, b := range glyphs {
+		if b == Space {
+			continue
+		}
+		if !t.naming[t.decode[b]] {
+			return nil, fmt.Errorf("character %q cannot be entered on the naming screen", t.decode[b])
+		}
+	}
+
+	return append(glyphs, Terminator), nil
+}
+
+// PlayerNameLength returns the maximum length of the player and rival names
+func PlayerNameLength(cs Charset) int {
+	if cs == Japanese {
+		return 5
+	}
+	return 7
+}
+
+// NicknameLength returns the maximum length of a Pokémon nickname
+func NicknameLength(cs Charset) int {
+	if cs == Japanese {
+		return 5
+	}
+	return 10
+}
+
+// split converts a string into glyph bytes, matching the longest token first
+func split(s string, cs Charset) ([]byte, error) {
+	t := tables[cs]
+	runes := []rune(s)
+	out := make([]byte, 0, len(runes))
+
+	for i := 0; i < len(runes); {
+		matched := false
+		for n := t.maxToken; n > 0; n-- {
+			if i+n > len(runes) {
+				continue
+			}
+			if b, ok := t.encode[string(runes[i:i+n])]; ok {
+				out = append(out, b)
+				i += n
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return nil, fmt.Errorf("character %q is not in the %s character table", runes[i], cs)
+		}
+	}
+
+	return out, nil
+}
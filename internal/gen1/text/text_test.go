@@ -0,0 +1,138 @@
+package text
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestDecodeEnglish(t *testing.T) {
+	// "ASH" followed by the terminator and padding
+	data := []byte{0x80, 0x92, 0x87, Terminator, 0x50, 0x50}
+	if got := Decode(data, English); got != "ASH" {
+		t.Errorf("Decode() = %q, want %q", got, "ASH")
+	}
+
+	data = []byte{0xE1, 0xE2, 0x7F, 0xF7, 0xF6, 0xE7, Terminator}
+	if got := Decode(data, English); got != "<PK><MN> 10!" {
+		t.Errorf("Decode() = %q", got)
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	tests := []struct {
+		cs   Charset
+		text string
+	}{
+		{English, "RED"},
+		{English, "Blue"},
+		{English, "Mr. Mime"},
+		{English, "Nidoran♀"},
+		{English, "Farfetch'd"},
+		{English, "<PK>MON 99"},
+		{Japanese, "サトシ"},
+		{Japanese, "ピカチュウ"},
+		{Japanese, "ガーディ"},
+		{Japanese, "ばあちゃん"},
+		{German, "Jürgen"},
+		{French, "Hélène"},
+		{French, "l'eau"},
+		{Spanish, "Ñoño"},
+		{Italian, "Niccolò"},
+	}
+
+	for _, tt := range tests {
+		encoded, err := Encode(tt.text, tt.cs)
+		if err != nil {
+			t.Errorf("Encode(%q) error = %v", tt.text, err)
+			continue
+		}
+		if encoded[len(encoded)-1] != Terminator {
+			t.Errorf("Encode(%q) is not terminated", tt.text)
+		}
+		if got := Decode(encoded, tt.cs); got != tt.text {
+			t.Errorf("Decode(Encode(%q)) = %q", tt.text, got)
+		}
+	}
+}
+
+func TestEveryGlyphRoundTrips(t *testing.T) {
+	for _, cs := range []Charset{English, Japanese, French, German, Italian, Spanish} {
+		for b := 0; b < 256; b++ {
+			glyph, ok := tables[cs].decode[byte(b)]
+			if !ok {
+				continue
+			}
+			encoded, err := Encode(glyph, cs)
+			if err != nil {
+				t.Errorf("%s: Encode(%q) error = %v", cs, glyph, err)
+				continue
+			}
+			// Duplicate glyphs encode to the first byte drawing them
+			if got := Decode(encoded, cs); got != glyph {
+				t.Errorf("%s: glyph 0x%02X %q round-trips to %q", cs, b, glyph, got)
+			}
+		}
+	}
+}
+
+func TestEncodeName(t *testing.T) {
+	got, err := EncodeName("RED", English, PlayerNameLength(English))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []byte{0x91, 0x84, 0x83, Terminator}; !bytes.Equal(got, want) {
+		t.Errorf("EncodeName(RED) = % X, want % X", got, want)
+	}
+
+	if _, err := EncodeName("ABCDEFGH", English, PlayerNameLength(English)); err == nil {
+		t.Error("EncodeName() should reject 8 characters")
+	}
+	if _, err := EncodeName("ASH1", English, PlayerNameLength(English)); err == nil {
+		t.Error("EncodeName() should reject digits, which the naming screen lacks")
+	}
+	if _, err := EncodeName("ASH's", English, PlayerNameLength(English)); err == nil {
+		t.Error("EncodeName() should reject contraction glyphs")
+	}
+	if _, err := EncodeName("Åsa", English, PlayerNameLength(English)); err == nil {
+		t.Error("EncodeName() should reject characters without a glyph")
+	}
+	if _, err := EncodeName("", English, PlayerNameLength(English)); err == nil {
+		t.Error("EncodeName() should reject an empty name")
+	}
+
+	if _, err := EncodeName("サトシ", Japanese, PlayerNameLength(Japanese)); err != nil {
+		t.Errorf("EncodeName(サトシ) error = %v", err)
+	}
+	if _, err := EncodeName("ピカチュウだ", Japanese, PlayerNameLength(Japanese)); err == nil {
+		t.Error("EncodeName() should reject 6 kana")
+	}
+}
+
+func TestEuropeanTables(t *testing.T) {
+	// 0xC3 is ä in every PAL table but has no glyph in the English one
+	data := []byte{0x89, 0xC3, 0x8D, 0xA4, Terminator}
+	if got := Decode(data, German); got != "JäNe" {
+		t.Errorf("Decode(German) = %q", got)
+	}
+	if Decodable(data, English) {
+		t.Error("Decodable(English) should be false for 0xC3")
+	}
+	if !Decodable(data, Spanish) {
+		t.Error("Decodable(Spanish) should be true for 0xC3")
+	}
+
+	// 0xBC is a contraction in English, which the naming screen cannot produce
+	if name := []byte{0x87, 0xBC, 0xAB, Terminator}; IsValidName(name, English) || !IsValidName(name, French) {
+		t.Error("IsValidName() should only accept 0xBC as the French é")
+	}
+
+	if _, err := EncodeName("Jürgen", German, PlayerNameLength(German)); err != nil {
+		t.Errorf("EncodeName(Jürgen) error = %v", err)
+	}
+	if _, err := EncodeName("Jürgen", English, PlayerNameLength(English)); err == nil {
+		t.Error("EncodeName(English) should reject ü")
+	}
+	if _, err := EncodeName("Ñoño", French, PlayerNameLength(French)); err == nil {
+		t.Error("EncodeName(French) should reject ñ")
+	}
+}
@@ -0,0 +1,100 @@
+package trainer
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/abravonunez/raracandy/internal/gen1/save"
+)
+
+// Badge is one of the eight Kanto gym badges, numbered in bit order
+type Badge int
+
+const (
+	BadgeBoulder Badge = iota
+	BadgeCascade
+	BadgeThunder
+	BadgeRainbow
+	BadgeSoul
+	BadgeMarsh
+	BadgeVolcano
+	BadgeEarth
+)
+
+// BadgeCount is the number of gym badges
+const BadgeCount = 8
+
+var badgeNames = [BadgeCount]string{"Boulder", "Cascade", "Thunder", "Rainbow", "Soul", "Marsh", "Volcano", "Earth"}
+
+func (b Badge) String() string {
+	if b < 0 || b >= BadgeCount {
+		return fmt.Sprintf("Badge(%d)", int(b))
+	}
+	return badgeNames[b]
+}
+
+// AllBadges returns every badge in bit order
+func AllBadges() []Badge {
+	badges := make([]Badge, BadgeCount)
+	for i := range badges {
+		badges[i] = Badge(i)
+	}
+	return badges
+}
+
+// ParseBadge parses a badge name ("cascade" or "Cascade Badge")
+func ParseBadge(name string) (Badge, error) {
+	normalized := strings.TrimSuffix(strings.ToLower(strings.TrimSpace(name)), " badge")
+	for i, n := range badgeNames {
+		if strings.ToLower(n) == normalized {
+			return Badge(i), nil
+		}
+	}
+	return 0, fmt.Errorf("unknown badge %q (expected boulder, cascade, thunder, rainbow, soul, marsh, volcano or earth)", name)
+}
+
+// Badges is the badge bitfield
+type Badges byte
+
+// Has reports whether a badge is held
+func (b Badges) Has(badge Badge) bool {
+	return b&(1<<badge) != 0
+}
+
+// With returns the bitfield with a badge added or removed
+func (b Badges) With(badge Badge, on bool) Badges {
+	if on {
+		return b | 1<<badge
+	}
+	return b &^ (1 << badge)
+}
+
+// List returns the badges held, in bit order
+func (b Badges) List() []Badge {
+	badges := make([]Badge, 0, BadgeCount)
+	for _, badge := range AllBadges() {
+		if b.Has(badge) {
+			badges = append(badges, badge)
+		}
+	}
+	return badges
+}
+
+// Count returns the number of badges held
+func (b Badges) Count() int {
+	return len(b.List())
+}
+
+// GetBadges returns the badges held by the player
+func GetBadges(s *save.Save) Badges {
+	return Badges(s.GetByte(s.GetProfile().OffsetBadges))
+}
+
+// SetBadges writes the badge bitfield. Gym leader event flags are left
+// alone; CheckIntegrity warns when the two disagree.
+func SetBadges(s *save.Save, b Badges) error {
+	if err := s.SetByte(s.GetProfile().OffsetBadges, byte(b)); err != nil {
+		return fmt.Errorf("failed to write badges: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,111 @@
+package trainer
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/abravonunez/raracandy/internal/gen1/save"
+)
+
+const (
+	// MaxHours is where the clock stops: on reaching it the game sets the
+	// maxed flag and shows 255:59 from then on
+	MaxHours = 255
+
+	playTimeSize = 5
+	maxedFlag    = 0xFF
+)
+
+// PlayTime is the play time shown on the trainer card. The game stops
+// counting at 255:59:59 and sets Maxed.
+type PlayTime struct {
+	Hours   byte
+	Minutes byte
+	Seconds byte
+	Frames  byte // 1/60 of a second
+	Maxed   bool
+}
+
+func (t PlayTime) String() string {
+	return fmt.Sprintf("%d:%02d:%02d", t.Hours, t.Minutes, t.Seconds)
+}
+
+// MaxPlayTime returns the capped play time as the game stores it
+func MaxPlayTime() PlayTime {
+	return PlayTime{Hours: MaxHours, Minutes: 59, Seconds: 59, Frames: 0, Maxed: true}
+}
+
+// Validate checks the ranges the in-game clock can produce
+func (t PlayTime) Validate() error {
+	if t.Minutes > 59 {
+		return fmt.Errorf("minutes %d out of range (0-59)", t.Minutes)
+	}
+	if t.Seconds > 59 {
+		return fmt.Errorf("seconds %d out of range (0-59)", t.Seconds)
+	}
+	if t.Frames > 59 {
+		return fmt.Errorf("frames %d out of range (0-59)", t.Frames)
+	}
+	if t.Maxed != (t.Hours == MaxHours) {
+		return fmt.Errorf("the clock stops at %d:59 with the maxed flag set; one cannot be set without the other", MaxHours)
+	}
+	return nil
+}
+
+// ParsePlayTime parses "h:mm" or "h:mm:ss". The only time with 255 hours
+// is the capped clock, 255:59 or 255:59:59 (stored with the maxed flag);
+// other 255-hour times are refused rather than silently rounded up.
+func ParsePlayTime(s string) (PlayTime, error) {
+	parts := strings.Split(strings.TrimSpace(s), ":")
+	if len(parts) < 2 || len(parts) > 3 {
+		return PlayTime{}, fmt.Errorf("invalid play time %q (expected h:mm or h:mm:ss)", s)
+	}
+
+	values := make([]byte, 3)
+	for i, part := range parts {
+		n, err := strconv.ParseUint(part, 10, 8)
+		if err != nil {
+			return PlayTime{}, fmt.Errorf("invalid play time %q (expected h:mm or h:mm:ss, at most %d hours)", s, MaxHours)
+		}
+		values[i] = byte(n)
+	}
+
+	t := PlayTime{Hours: values[0], Minutes: values[1], Seconds: values[2], Maxed: values[0] == MaxHours}
+	if t.Maxed && (t.Minutes != 59 || (len(parts) == 3 && t.Seconds != 59)) {
+		return PlayTime{}, fmt.Errorf("invalid play time %q: the clock stops at %d:59:59, the only time with %d hours (use --max to set it)", s, MaxHours, MaxHours)
+	}
+	if err := t.Validate(); err != nil {
+		return PlayTime{}, err
+	}
+	if t.Maxed {
+		return MaxPlayTime(), nil
+	}
+	return t, nil
+}
+
+// GetPlayTime returns the play time. It is stored as hours, the maxed
+// flag, minutes, seconds and frames.
+func GetPlayTime(s *save.Save) PlayTime {
+	b := s.GetBytes(s.GetProfile().OffsetPlayTime, playTimeSize)
+	if len(b) != playTimeSize {
+		return PlayTime{}
+	}
+	return PlayTime{Hours: b[0], Maxed: b[1] != 0, Minutes: b[2], Seconds: b[3], Frames: b[4]}
+}
+
+// SetPlayTime validates and writes the play time
+func SetPlayTime(s *save.Save, t PlayTime) error {
+	if err := t.Validate(); err != nil {
+		return err
+	}
+
+	var maxed byte
+	if t.Maxed {
+		maxed = maxedFlag
+	}
+	if err := s.SetBytes(s.GetProfile().OffsetPlayTime, []byte{t.Hours, maxed, t.Minutes, t.Seconds, t.Frames}); err != nil {
+		return fmt.Errorf("failed to write play time: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,69 @@
+package trainer
+
+import (
+	"fmt"
+
+	"github.com/abravonunez/raracandy/internal/gen1/save"
+	"github.com/abravonunez/raracandy/internal/gen1/text"
+)
+
+// GetPlayerName returns the player's name
+func GetPlayerName(s *save.Save) string {
+	prof := s.GetProfile()
+	return text.Decode(s.GetBytes(prof.OffsetPlayerName, prof.NameLength), prof.Charset)
+}
+
+// SetPlayerName validates and writes the player's name
+func SetPlayerName(s *save.Save, name string) error {
+	return setName(s, s.GetProfile().OffsetPlayerName, name)
+}
+
+// GetRivalName returns the rival's name
+func GetRivalName(s *save.Save) string {
+	prof := s.GetProfile()
+	return text.Decode(s.GetBytes(prof.OffsetRivalName, prof.NameLength), prof.Charset)
+}
+
+// SetRivalName validates and writes the rival's name
+func SetRivalName(s *save.Save, name string) error {
+	return setName(s, s.GetProfile().OffsetRivalName, name)
+}
+
+// GetID returns the player's trainer ID (stored big-endian)
+func GetID(s *save.Save) uint16 {
+	b := s.GetBytes(s.GetProfile().OffsetPlayerID, 2)
+	if len(b) != 2 {
+		return 0
+	}
+	return uint16(b[0])<<8 | uint16(b[1])
+}
+
+// SetID writes the player's trainer ID. Pokémon caught earlier keep their
+// own OT ID, so changing it makes them count as traded.
+func SetID(s *save.Save, id uint16) error {
+	if err := s.SetBytes(s.GetProfile().OffsetPlayerID, []byte{byte(id >> 8), byte(id)}); err != nil {
+		return fmt.Errorf("failed to write trainer ID: %w", err)
+	}
+	return nil
+}
+
+// setName encodes a name and writes it padded with terminators, like the
+// naming screen does
+func setName(s *save.Save, offset int, name string) error {
+	prof := s.GetProfile()
+	encoded, err := text.EncodeName(name, prof.Charset, text.PlayerNameLength(prof.Charset))
+	if err != nil {
+		return err
+	}
+
+	data := make([]byte, prof.NameLength)
+	for i := range data {
+		data[i] = text.Terminator
+	}
+	copy(data, encoded)
+
+	if err := s.SetBytes(offset, data); err != nil {
+		return fmt.Errorf("failed to write name: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,143 @@
+package trainer
+
+import (
+	"testing"
+
+	"github.com/abravonunez/raracandy/internal/gen1/save"
+)
+
+func TestNames(t *testing.T) {
+	s := save.CreateTestSave()
+	prof := s.GetProfile()
+
+	if err := SetPlayerName(s, "ASH"); err != nil {
+		t.Fatal(err)
+	}
+	if err := SetRivalName(s, "Gary"); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := GetPlayerName(s); got != "ASH" {
+		t.Errorf("GetPlayerName() = %q, want ASH", got)
+	}
+	if got := GetRivalName(s); got != "Gary" {
+		t.Errorf("GetRivalName() = %q, want Gary", got)
+	}
+	// The rest of the field is padded with terminators
+	if b := s.GetByte(prof.OffsetPlayerName + prof.NameLength - 1); b != 0x50 {
+		t.Errorf("last name byte = 0x%02X, want 0x50", b)
+	}
+
+	if err := SetPlayerName(s, "TOOLONGNAME"); err == nil {
+		t.Error("SetPlayerName() should reject names longer than 7 characters")
+	}
+	if got := GetPlayerName(s); got != "ASH" {
+		t.Errorf("rejected name modified the save: %q", got)
+	}
+}
+
+func TestGetID(t *testing.T) {
+	s := save.CreateTestSave()
+	s.SetBytes(s.GetProfile().OffsetPlayerID, []byte{0x30, 0x39})
+	if got := GetID(s); got != 12345 {
+		t.Errorf("GetID() = %d, want 12345", got)
+	}
+}
+
+func TestBadges(t *testing.T) {
+	s := save.CreateTestSave()
+
+	badges := GetBadges(s).With(BadgeBoulder, true).With(BadgeEarth, true)
+	if err := SetBadges(s, badges); err != nil {
+		t.Fatal(err)
+	}
+	if got := s.GetByte(s.GetProfile().OffsetBadges); got != 0x81 {
+		t.Errorf("badge byte = 0x%02X, want 0x81", got)
+	}
+
+	got := GetBadges(s)
+	if !got.Has(BadgeBoulder) || got.Has(BadgeCascade) || got.Count() != 2 {
+		t.Errorf("GetBadges() = %v", got.List())
+	}
+	if got.With(BadgeBoulder, false).Has(BadgeBoulder) {
+		t.Error("With(false) did not remove the badge")
+	}
+
+	for _, name := range []string{"cascade", "Cascade Badge", " CASCADE "} {
+		if b, err := ParseBadge(name); err != nil || b != BadgeCascade {
+			t.Errorf("ParseBadge(%q) = %v, %v", name, b, err)
+		}
+	}
+	if _, err := ParseBadge("zephyr"); err == nil {
+		t.Error("ParseBadge(zephyr) should fail")
+	}
+}
+
+func TestGetPlayTime(t *testing.T) {
+	s := save.CreateTestSave()
+	s.SetBytes(s.GetProfile().OffsetPlayTime, []byte{12, 0, 3, 4, 5})
+	want := PlayTime{Hours: 12, Minutes: 3, Seconds: 4, Frames: 5}
+	if got := GetPlayTime(s); got != want {
+		t.Errorf("GetPlayTime() = %+v, want %+v", got, want)
+	}
+	if got := want.String(); got != "12:03:04" {
+		t.Errorf("String() = %q", got)
+	}
+}
+
+func TestSetPlayTime(t *testing.T) {
+	s := save.CreateTestSave()
+	offset := s.GetProfile().OffsetPlayTime
+
+	if err := SetPlayTime(s, PlayTime{Hours: 100, Minutes: 59, Seconds: 1, Frames: 30}); err != nil {
+		t.Fatal(err)
+	}
+	if got := s.GetBytes(offset, 5); string(got) != string([]byte{100, 0, 59, 1, 30}) {
+		t.Errorf("play time bytes = % X", got)
+	}
+
+	if err := SetPlayTime(s, MaxPlayTime()); err != nil {
+		t.Fatal(err)
+	}
+	if got := s.GetBytes(offset, 5); string(got) != string([]byte{255, 0xFF, 59, 59, 0}) {
+		t.Errorf("maxed play time bytes = % X", got)
+	}
+	if got := GetPlayTime(s); !got.Maxed || got.String() != "255:59:59" {
+		t.Errorf("GetPlayTime() = %+v", got)
+	}
+
+	for _, bad := range []PlayTime{
+		{Minutes: 60},
+		{Seconds: 60},
+		{Frames: 60},
+		{Hours: 10, Maxed: true},
+		{Hours: 255},
+	} {
+		if err := SetPlayTime(s, bad); err == nil {
+			t.Errorf("SetPlayTime(%+v) should fail", bad)
+		}
+	}
+}
+
+func TestParsePlayTime(t *testing.T) {
+	tests := []struct {
+		in   string
+		want PlayTime
+	}{
+		{"12:34", PlayTime{Hours: 12, Minutes: 34}},
+		{"0:05:09", PlayTime{Minutes: 5, Seconds: 9}},
+		{"255:59", MaxPlayTime()},
+		{"255:59:59", MaxPlayTime()},
+	}
+	for _, tt := range tests {
+		got, err := ParsePlayTime(tt.in)
+		if err != nil || got != tt.want {
+			t.Errorf("ParsePlayTime(%q) = %+v, %v; want %+v", tt.in, got, err, tt.want)
+		}
+	}
+	for _, bad := range []string{"12", "1:60", "1:00:60", "256:00", "a:bc", "255:60", "255:00", "255:30:59", "255:59:00"} {
+		if _, err := ParsePlayTime(bad); err == nil {
+			t.Errorf("ParsePlayTime(%q) should fail", bad)
+		}
+	}
+}
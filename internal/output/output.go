@@ -0,0 +1,264 @@
+package output
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Format selects how a command prints its results
+type Format int
+
+const (
+	FormatText Format = iota
+	FormatJSON
+	FormatYAML
+)
+
+func (f Format) String() string {
+	switch f {
+	case FormatJSON:
+		return "json"
+	case FormatYAML:
+		return "yaml"
+	default:
+		return "text"
+	}
+}
+
+// ParseFormat parses an --output value (text, json, yaml)
+func ParseFormat(s string) (Format, error) {
+	switch strings.ToLower(s) {
+	case "text", "":
+		return FormatText, nil
+	case "json":
+		return FormatJSON, nil
+	case "yaml", "yml":
+		return FormatYAML, nil
+	default:
+		return FormatText, fmt.Errorf("unknown output format %q (expected text, json or yaml)", s)
+	}
+}
+
+// Write serializes v as JSON or YAML. Field names and omitempty come from
+// the json struct tags so both formats share one schema.
+func Write(w io.Writer, format Format, v any) error {
+	switch format {
+	case FormatJSON:
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(v)
+	case FormatYAML:
+		var sb strings.Builder
+		writeYAML(&sb, reflect.ValueOf(v), 0)
+		_, err := io.WriteString(w, sb.String())
+		return err
+	default:
+		return fmt.Errorf("format %s is not machine-readable", format)
+	}
+}
+
+// writeYAML emits a value in block style. Scalars are written inline by the
+// caller's key; mappings and sequences start on the next line.
+func writeYAML(sb *strings.Builder, v reflect.Value, indent int) {
+	v = deref(v)
+	switch v.Kind() {
+	case reflect.Struct:
+		fields := structFields(v)
+		if len(fields) == 0 {
+			sb.WriteString(pad(indent) + "{}\n")
+		}
+		for _, f := range fields {
+			writeEntry(sb, f.name, f.value, indent)
+		}
+	case reflect.Map:
+		keys := v.MapKeys()
+		if len(keys) == 0 {
+			sb.WriteString(pad(indent) + "{}\n")
+		}
+		names := make([]string, 0, len(keys))
+		for _, k := range keys {
+			names = append(names, fmt.Sprint(k.Interface()))
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			writeEntry(sb, name, v.MapIndex(reflect.ValueOf(name).Convert(v.Type().Key())), indent)
+		}
+	case reflect.Slice, reflect.Array:
+		if v.Len() == 0 {
+			sb.WriteString(pad(indent) + "[]\n")
+		}
+		for i := 0; i < v.Len(); i++ {
+			elem := deref(v.Index(i))
+			if isScalar(elem) {
+				sb.WriteString(pad(indent) + "- " + scalar(elem) + "\n")
+				continue
+			}
+			// Nested collection: write it indented, then turn the first
+			// line's indentation into the sequence dash
+			var inner strings.Builder
+			writeYAML(&inner, elem, indent+2)
+			text := inner.String()
+			sb.WriteString(pad(indent) + "- " + text[indent+2:])
+		}
+	default:
+		sb.WriteString(pad(indent) + scalar(v) + "\n")
+	}
+}
+
+func writeEntry(sb *strings.Builder, key string, value reflect.Value, indent int) {
+	value = deref(value)
+	if isScalar(value) {
+		sb.WriteString(pad(indent) + quoteString(key) + ": " + scalar(value) + "\n")
+		return
+	}
+	if isEmptyCollection(value) {
+		empty := "[]"
+		if value.Kind() == reflect.Struct || value.Kind() == reflect.Map {
+			empty = "{}"
+		}
+		sb.WriteString(pad(indent) + quoteString(key) + ": " + empty + "\n")
+		return
+	}
+	sb.WriteString(pad(indent) + quoteString(key) + ":\n")
+	writeYAML(sb, value, indent+2)
+}
+
+type field struct {
+	name  string
+	value reflect.Value
+}
+
+// structFields lists exported fields in declaration order, named and
+// filtered like encoding/json does
+func structFields(v reflect.Value) []field {
+	t := v.Type()
+	fields := make([]field, 0, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		if !sf.IsExported() {
+			continue
+		}
+		name := sf.Name
+		omitEmpty := false
+		if tag, ok := sf.Tag.Lookup("json"); ok {
+			parts := strings.Split(tag, ",")
+			if parts[0] == "-" {
+				continue
+			}
+			if parts[0] != "" {
+				name = parts[0]
+			}
+			for _, opt := range parts[1:] {
+				if opt == "omitempty" {
+					omitEmpty = true
+				}
+			}
+		}
+		fv := v.Field(i)
+		if omitEmpty && fv.IsZero() {
+			continue
+		}
+		if omitEmpty && (fv.Kind() == reflect.Slice || fv.Kind() == reflect.Map) && fv.Len() == 0 {
+			continue
+		}
+		fields = append(fields, field{name: name, value: fv})
+	}
+	return fields
+}
+
+func deref(v reflect.Value) reflect.Value {
+	for v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return v
+		}
+		v = v.Elem()
+	}
+	return v
+}
+
+func isScalar(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Struct, reflect.Map, reflect.Slice, reflect.Array:
+		return false
+	default:
+		return true
+	}
+}
+
+func isEmptyCollection(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Map, reflect.Slice, reflect.Array:
+		return v.Len() == 0
+	case reflect.Struct:
+		return len(structFields(v)) == 0
+	default:
+		return false
+	}
+}
+
+// scalar formats a scalar value, quoting strings that YAML would otherwise
+// read as another type or that contain special characters
+func scalar(v reflect.Value) string {
+	switch v.Kind() {
+	case reflect.Invalid, reflect.Pointer, reflect.Interface:
+		return "null"
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10)
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'g', -1, 64)
+	case reflect.String:
+		return quoteString(v.String())
+	default:
+		return quoteString(fmt.Sprint(v.Interface()))
+	}
+}
+
+func quoteString(s string) string {
+	if needsQuotes(s) {
+		return strconv.Quote(s)
+	}
+	return s
+}
+
+func needsQuotes(s string) bool {
+	if s == "" {
+		return true
+	}
+	switch strings.ToLower(s) {
+	case "true", "false", "yes", "no", "on", "off", "null", "~", "y", "n":
+		return true
+	}
+	if _, err := strconv.ParseFloat(s, 64); err == nil {
+		return true
+	}
+	if _, err := strconv.ParseInt(s, 0, 64); err == nil {
+		return true
+	}
+	if strings.ContainsAny(s[:1], "-?:,[]{}#&*!|>'\"%@` ") {
+		return true
+	}
+	// YAML 1.1 reads colon-separated digits such as a play time of 12:34:56
+	// as a base-60 number, so any colon is quoted
+	if strings.HasSuffix(s, " ") || strings.Contains(s, ":") || strings.Contains(s, " #") {
+		return true
+	}
+	for _, r := range s {
+		if r < 0x20 || r == 0x7F {
+			return true
+		}
+	}
+	return false
+}
+
+func pad(n int) string {
+	return strings.Repeat(" ", n)
+}
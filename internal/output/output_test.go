@@ -0,0 +1,116 @@
+package output
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+)
+
+type testItem struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+type testDoc struct {
+	Version  int        `json:"schema_version"`
+	Name     string     `json:"name"`
+	Checksum string     `json:"checksum"`
+	Valid    bool       `json:"valid"`
+	Ratio    float64    `json:"ratio"`
+	Items    []testItem `json:"items"`
+	Empty    []string   `json:"empty"`
+	Tags     []string   `json:"tags"`
+	Missing  *bool      `json:"missing,omitempty"`
+	Nested   struct {
+		Errors []string `json:"errors"`
+	} `json:"nested"`
+	internal int
+}
+
+func testValue() testDoc {
+	doc := testDoc{
+		Version:  1,
+		Name:     "Pokémon Yellow",
+		Checksum: "0x28",
+		Valid:    true,
+		Ratio:    0.75,
+		Items:    []testItem{{0x28, "Rare Candy"}, {0x01, "yes"}},
+		Empty:    []string{},
+		Tags:     []string{"a: b", "plain"},
+	}
+	doc.Nested.Errors = []string{"Invalid checksum"}
+	return doc
+}
+
+func TestWriteYAML(t *testing.T) {
+	var buf bytes.Buffer
+	if err := Write(&buf, FormatYAML, testValue()); err != nil {
+		t.Fatal(err)
+	}
+
+	want := `schema_version: 1
+name: Pokémon Yellow
+checksum: "0x28"
+valid: true
+ratio: 0.75
+items:
+  - id: 40
+    name: Rare Candy
+  - id: 1
+    name: "yes"
+empty: []
+tags:
+  - "a: b"
+  - plain
+nested:
+  errors:
+    - Invalid checksum
+`
+	if got := buf.String(); got != want {
+		t.Errorf("YAML output:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestWriteYAMLPlayTime(t *testing.T) {
+	var buf bytes.Buffer
+	doc := struct {
+		PlayTime string `json:"play_time"`
+		Map      string `json:"map"`
+	}{"12:34:56", "route:17"}
+	if err := Write(&buf, FormatYAML, doc); err != nil {
+		t.Fatal(err)
+	}
+
+	want := `play_time: "12:34:56"
+map: "route:17"
+`
+	if got := buf.String(); got != want {
+		t.Errorf("YAML output:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestWriteJSON(t *testing.T) {
+	var buf bytes.Buffer
+	if err := Write(&buf, FormatJSON, testValue()); err != nil {
+		t.Fatal(err)
+	}
+
+	var decoded testDoc
+	if err := json.Unmarshal(buf.Bytes(), &decoded); err != nil {
+		t.Fatalf("output is not valid JSON: %v", err)
+	}
+	if decoded.Checksum != "0x28" || len(decoded.Items) != 2 || decoded.Missing != nil {
+		t.Errorf("decoded = %+v", decoded)
+	}
+}
+
+func TestParseFormat(t *testing.T) {
+	for in, want := range map[string]Format{"": FormatText, "text": FormatText, "JSON": FormatJSON, "yml": FormatYAML} {
+		if got, err := ParseFormat(in); err != nil || got != want {
+			t.Errorf("ParseFormat(%q) = %v, %v", in, got, err)
+		}
+	}
+	if _, err := ParseFormat("xml"); err == nil {
+		t.Error("ParseFormat(xml) should fail")
+	}
+}